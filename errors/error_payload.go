@@ -0,0 +1,82 @@
+package errors
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+const errorPayloadPlaceholder = "{...}"
+
+// formatErrorPayloads moves complete JSON objects (e.g. response bodies)
+// into indented blocks under lines that contain them. Go-syntax literals,
+// quoted strings, raw sections (e.g. ...|{"a":|...) and other text
+// in curly brackets (e.g. {name: required}) are kept as is.
+func formatErrorPayloads(msg string) string {
+	var result []string
+
+	for _, line := range strings.Split(msg, "\n") {
+		rootPiece, _ := ParseErrorPieces(line)
+
+		var payloads []string
+		rootPiece.extractPayloads(&payloads)
+
+		if len(payloads) == 0 {
+			result = append(result, line)
+			continue
+		}
+
+		indent, bullet, _ := splitErrorLine(line)
+		blockIndent := indent + strings.Repeat(" ", len(bullet)) + "  "
+
+		result = append(result, rootPiece.AsString())
+
+		for _, payload := range payloads {
+			for _, payloadLine := range strings.Split(payload, "\n") {
+				result = append(result, blockIndent+payloadLine)
+			}
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func (p *ErrorPiece) extractPayloads(payloads *[]string) {
+	for i, piece := range p.Pieces {
+		if !piece.IsContainer() || piece.IsLeafContainer() {
+			continue
+		}
+
+		if i > 0 && piece.isGoLiteral(p.Pieces[i-1]) {
+			continue
+		}
+
+		if piece.ContainerType == curlyOpen && piece.closed {
+			if payload, ok := formatErrorPayload(piece.AsString()); ok {
+				*payloads = append(*payloads, payload)
+				*piece = ErrorPiece{Value: errorPayloadPlaceholder}
+				continue
+			}
+		}
+
+		piece.extractPayloads(payloads)
+	}
+}
+
+// formatErrorPayload re-indents JSON object
+func formatErrorPayload(str string) (string, bool) {
+	var obj map[string]interface{}
+
+	if json.Unmarshal([]byte(str), &obj) != nil || len(obj) == 0 {
+		return "", false
+	}
+
+	var buf bytes.Buffer
+
+	err := json.Indent(&buf, []byte(str), "", "  ")
+	if err != nil {
+		return "", false
+	}
+
+	return buf.String(), true
+}
@@ -0,0 +1,106 @@
+package errors_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cppforlife/go-cli-ui/errors"
+)
+
+func TestErrorPayloads(t *testing.T) {
+	tests := []errorPayloadTest{
+		{
+			Description: "JSON objects are indented under their line",
+			Actual: fmt.Errorf("Getting deployment: %w", fmt.Errorf(
+				`Unexpected response: {"kind":"Status","status":"Failure","details":{"name":"frontend","kind":"deployments"},"code":404} (reason: NotFound)`)),
+			Expected: `
+Getting deployment:
+  Unexpected response: {...} (reason: NotFound)
+    {
+      "kind": "Status",
+      "status": "Failure",
+      "details": {
+        "name": "frontend",
+        "kind": "deployments"
+      },
+      "code": 404
+    }
+`,
+		},
+		{
+			Description: "text in curly brackets that is not JSON is kept",
+			Actual:      fmt.Errorf("Running: %w", fmt.Errorf("Expected key {name: required} to be set (stderr: {error: boom})")),
+			Expected: `
+Running:
+  Expected key {name: required} to be set (stderr: {error: boom})
+`,
+		},
+		{
+			Description: "raw sections, quoted strings and Go literals are kept",
+			Actual: fmt.Errorf(`Decoding: %w`, fmt.Errorf(
+				`error found in #10 byte of ...|{"ports":"foo"}|..., body "{\"a\":1}", labels map[string]string{"app":"guestbook"}, selector {Invalid}`)),
+			Expected: `
+Decoding:
+  error found in #10 byte of ...|{"ports":"foo"}|..., body "{\"a\":1}", labels map[string]string{"app":"guestbook"}, selector {Invalid}
+`,
+		},
+		{
+			Description: "empty objects are kept",
+			Actual:      fmt.Errorf(`Decoding: %w`, fmt.Errorf(`Unexpected response: {}`)),
+			Expected: `
+Decoding:
+  Unexpected response: {}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test.Check(t)
+	}
+}
+
+func TestErrorPayloadsWithoutOpts(t *testing.T) {
+	err := errors.NewMultiLineError(fmt.Errorf(`Unexpected response: {"kind":"Status"}`))
+
+	if actual := err.Error(); actual != `Unexpected response: {"kind":"Status"}` {
+		t.Fatalf("expected error to be kept as is: >>>%s<<<", actual)
+	}
+}
+
+func TestSemiStructuredErrorPayloads(t *testing.T) {
+	err := errors.NewSemiStructuredErrorWithOpts(fmt.Errorf(
+		`Service "redis" is invalid: [spec.ports: Required value, metadata.annotations: Invalid value: {"a":"b"}: must be string]`),
+		errors.SemiStructuredErrorOpts{FormatPayloads: true})
+
+	expected := `
+Service "redis" is invalid: 
+
+  - spec.ports: Required value
+
+  - metadata.annotations: Invalid value: {...}: must be string
+      {
+        "a": "b"
+      }
+`
+
+	if actual := strings.TrimSpace(err.Error()); actual != strings.TrimSpace(expected) {
+		t.Fatalf("expected error to match:\n>>>%s<<< vs \n>>>%s<<<", actual, expected)
+	}
+}
+
+type errorPayloadTest struct {
+	Description string
+	Actual      error
+	Expected    string
+}
+
+func (e errorPayloadTest) Check(t *testing.T) {
+	actual := errors.NewMultiLineErrorWithOpts(e.Actual, errors.MultiLineErrorOpts{FormatPayloads: true}).Error()
+	e.Expected = strings.Trim(e.Expected, "\n")
+
+	if actual != e.Expected {
+		t.Fatalf("(%s) expected error to match:\n%d chars >>>%s<<< vs \n%d chars >>>%s<<<",
+			e.Description, len(actual), actual, len(e.Expected), e.Expected)
+	}
+}
@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 )
@@ -13,6 +14,10 @@ const (
 	customOpen = "~"
 	rawOpen    = "...|"
 
+	doubleQuoteOpen = `"`
+	singleQuoteOpen = "'"
+	backtickOpen    = "`"
+
 	braketClose = "]"
 	parenClose  = ")"
 	curlyClose  = "}"
@@ -27,6 +32,11 @@ var (
 		rootOpen:   "",
 		customOpen: "",
 		rawOpen:    "",
+
+		// Quoted and raw pieces include their delimiters
+		doubleQuoteOpen: "",
+		singleQuoteOpen: "",
+		backtickOpen:    "",
 	}
 	sepClosers = map[string]string{
 		braketOpen: string(braketClose),
@@ -35,6 +45,15 @@ var (
 		rootOpen:   "",
 		customOpen: "",
 		rawOpen:    "",
+
+		doubleQuoteOpen: "",
+		singleQuoteOpen: "",
+		backtickOpen:    "",
+	}
+	closerOpeners = map[string]string{
+		braketClose: braketOpen,
+		parenClose:  parenOpen,
+		curlyClose:  curlyOpen,
 	}
 )
 
@@ -50,52 +69,252 @@ type ErrorPiece struct {
 	closed bool
 }
 
+// ErrorPieceDiagnostic describes a malformed part of a string
+// (e.g. unbalanced bracket) that was treated as text when parsing
+type ErrorPieceDiagnostic struct {
+	Offset  int // in bytes
+	Message string
+}
+
+// NewErrorPiecesFromString parses string into pieces; it returns false
+// if some parts of the string had to be treated as text (see ParseErrorPieces).
+// Returned pieces are usable either way.
 func NewErrorPiecesFromString(str string) (*ErrorPiece, bool) {
-	rootPiece := &ErrorPiece{
-		ContainerType: rootOpen,
+	rootPiece, diagnostics := ParseErrorPieces(str)
+	return rootPiece, len(diagnostics) == 0
+}
+
+// maxErrorPieceReparses limits attempts to find unbalanced openers
+const maxErrorPieceReparses = 10
+
+// ParseErrorPieces parses string into pieces based on brackets, quotes
+// and raw sections (e.g. ...|{"a":|...). Brackets within quotes are ignored.
+// Unbalanced brackets and quotes are treated as text and reported.
+func ParseErrorPieces(str string) (*ErrorPiece, []ErrorPieceDiagnostic) {
+	parser := newErrorPieceParser(str, map[int]bool{})
+
+	// Closer of an unclosed container may have been taken by an opener
+	// nested within it (e.g. [a: expect [ or n, but found "]); such
+	// openers are treated as text if that leaves fewer unclosed containers
+	for attempts := 0; attempts < maxErrorPieceReparses && len(parser.unclosed) > 0; {
+		var reparsed bool
+
+		for _, opener := range parser.nestedOpeners() {
+			if attempts == maxErrorPieceReparses {
+				break
+			}
+			attempts++
+
+			textOffsets := map[int]bool{opener: true}
+			for offset := range parser.textOffsets {
+				textOffsets[offset] = true
+			}
+
+			if nextParser := newErrorPieceParser(str, textOffsets); len(nextParser.unclosed) < len(parser.unclosed) {
+				parser, reparsed = nextParser, true
+				break
+			}
+		}
+
+		if !reparsed {
+			break
+		}
 	}
-	stack := []*ErrorPiece{rootPiece}
 
-	for i, f := range str {
-		if len(stack) == 0 {
-			return rootPiece, false
+	return parser.stack[0], parser.diagnostics
+}
+
+type errorPieceParser struct {
+	str string
+
+	stack   []*ErrorPiece
+	offsets []int // where containers in stack were opened
+
+	// textOffsets are openers that should be treated as text
+	textOffsets map[int]bool
+
+	closed   []errorPieceOpener
+	unclosed []errorPieceOpener
+
+	diagnostics []ErrorPieceDiagnostic
+}
+
+type errorPieceOpener struct {
+	containerType string
+	offset        int
+}
+
+func newErrorPieceParser(str string, textOffsets map[int]bool) *errorPieceParser {
+	parser := &errorPieceParser{
+		str:         str,
+		stack:       []*ErrorPiece{{ContainerType: rootOpen}},
+		offsets:     []int{0},
+		textOffsets: textOffsets,
+	}
+	parser.Parse()
+	return parser
+}
+
+// nestedOpeners returns openers of closed containers that are
+// within unclosed containers of the same type (innermost first)
+func (p *errorPieceParser) nestedOpeners() []int {
+	var result []int
+
+	for _, unclosed := range p.unclosed {
+		for i := len(p.closed) - 1; i >= 0; i-- {
+			closed := p.closed[i]
+			if closed.containerType == unclosed.containerType && closed.offset > unclosed.offset {
+				result = append(result, closed.offset)
+			}
 		}
+	}
+
+	return result
+}
 
-		charStr := string(f)
+func (p *errorPieceParser) Parse() {
+	for i := 0; i < len(p.str); {
+		charStr := p.str[i : i+1]
+		top := p.stack[len(p.stack)-1]
 
-		if !stack[len(stack)-1].IsLeafContainer() {
+		if !top.IsLeafContainer() {
 			switch charStr {
 			case braketOpen, curlyOpen, parenOpen:
-				piece := &ErrorPiece{ContainerType: charStr}
-				stack[len(stack)-1].AddPiece(piece)
-				stack = append(stack, piece)
+				if p.textOffsets[i] {
+					p.diagnose(i, "Unbalanced '%s' treated as text", charStr)
+					break
+				}
+				p.push(&ErrorPiece{ContainerType: charStr}, i)
+				i++
 				continue
 
 			case braketClose, curlyClose, parenClose:
-				stack[len(stack)-1].closed = true
-				stack = stack[:len(stack)-1]
+				p.close(charStr, i)
+				i++
 				continue
+
+			case doubleQuoteOpen, singleQuoteOpen, backtickOpen:
+				if end, ok := p.quoteEnd(i); ok {
+					top.AddPiece(&ErrorPiece{
+						ContainerType: charStr,
+						Pieces:        []*ErrorPiece{{Value: p.str[i:end]}},
+						closed:        true,
+					})
+					i = end
+					continue
+				}
 			}
 		}
 
 		switch {
-		case checkForward(str, i, rawOpen):
-			piece := &ErrorPiece{ContainerType: rawOpen}
-			piece.AddStr(charStr)
-			stack[len(stack)-1].AddPiece(piece)
-			stack = append(stack, piece)
+		case checkForward(p.str, i, rawOpen):
+			p.push(&ErrorPiece{ContainerType: rawOpen}, i)
+			p.stack[len(p.stack)-1].AddStr(charStr)
 
-		case checkBackward(str, i, rawClose):
-			stack[len(stack)-1].AddStr(charStr)
-			stack[len(stack)-1].closed = true
-			stack = stack[:len(stack)-1]
+		case checkBackward(p.str, i, rawClose) && top.ContainerType == rawOpen:
+			top.AddStr(charStr)
+			top.closed = true
+			p.pop()
 
 		default:
-			stack[len(stack)-1].AddStr(charStr)
+			top.AddStr(charStr)
 		}
+
+		i++
 	}
 
-	return rootPiece, len(stack) == 1
+	for len(p.stack) > 1 {
+		p.flattenTop()
+	}
+}
+
+func (p *errorPieceParser) push(piece *ErrorPiece, offset int) {
+	p.stack[len(p.stack)-1].AddPiece(piece)
+	p.stack = append(p.stack, piece)
+	p.offsets = append(p.offsets, offset)
+}
+
+func (p *errorPieceParser) pop() {
+	p.stack = p.stack[:len(p.stack)-1]
+	p.offsets = p.offsets[:len(p.offsets)-1]
+}
+
+// close closes matching container (containers opened
+// after it are unclosed) or treats closer as text
+func (p *errorPieceParser) close(closer string, offset int) {
+	for i := len(p.stack) - 1; i > 0; i-- {
+		if p.stack[i].ContainerType == closerOpeners[closer] {
+			for len(p.stack)-1 > i {
+				p.flattenTop()
+			}
+			p.stack[i].closed = true
+			p.closed = append(p.closed, errorPieceOpener{p.stack[i].ContainerType, p.offsets[i]})
+			p.pop()
+			return
+		}
+	}
+
+	p.diagnose(offset, "Unexpected '%s' treated as text", closer)
+	p.stack[len(p.stack)-1].AddStr(closer)
+}
+
+// flattenTop treats unclosed container opener as text
+// and moves container's pieces into its parent
+func (p *errorPieceParser) flattenTop() {
+	piece, offset := p.stack[len(p.stack)-1], p.offsets[len(p.offsets)-1]
+	p.pop()
+
+	p.diagnose(offset, "Unclosed '%s' treated as text", piece.ContainerType)
+	p.unclosed = append(p.unclosed, errorPieceOpener{piece.ContainerType, offset})
+
+	parent := p.stack[len(p.stack)-1]
+	parent.Pieces = parent.Pieces[:len(parent.Pieces)-1]
+	parent.AddStr(sepOpeners[piece.ContainerType])
+
+	for _, childPiece := range piece.Pieces {
+		if childPiece.IsContainer() {
+			parent.AddPiece(childPiece)
+		} else {
+			parent.AddStr(childPiece.Value)
+		}
+	}
+}
+
+// quoteEnd returns offset after closing quote. Quotes within words
+// (e.g. doesn't) and quotes that are not closed on the same line
+// (or before raw section) are treated as text.
+func (p *errorPieceParser) quoteEnd(offset int) (int, bool) {
+	quote := p.str[offset]
+
+	if quote == '\'' && offset > 0 && isWordChar(p.str[offset-1]) {
+		return 0, false
+	}
+
+	for i := offset + 1; i < len(p.str); i++ {
+		switch {
+		case p.str[i] == '\\' && quote != '`':
+			i++
+		case p.str[i] == quote:
+			return i + 1, true
+		case p.str[i] == '\n' || checkForward(p.str, i, rawOpen):
+			p.diagnose(offset, "Unclosed '%c' treated as text", quote)
+			return 0, false
+		}
+	}
+
+	p.diagnose(offset, "Unclosed '%c' treated as text", quote)
+	return 0, false
+}
+
+func (p *errorPieceParser) diagnose(offset int, pattern string, args ...interface{}) {
+	p.diagnostics = append(p.diagnostics, ErrorPieceDiagnostic{
+		Offset:  offset,
+		Message: fmt.Sprintf(pattern, args...),
+	})
+}
+
+func isWordChar(ch byte) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
 }
 
 func checkForward(str string, i int, sep string) bool {
@@ -119,7 +338,12 @@ func (p *ErrorPiece) IsContainer() bool {
 }
 
 func (p *ErrorPiece) IsLeafContainer() bool {
-	return p.ContainerType == rawOpen
+	switch p.ContainerType {
+	case rawOpen, doubleQuoteOpen, singleQuoteOpen, backtickOpen:
+		return true
+	default:
+		return false
+	}
 }
 
 func (p *ErrorPiece) AddPiece(piece *ErrorPiece) {
@@ -0,0 +1,117 @@
+package errors_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cppforlife/go-cli-ui/errors"
+)
+
+func TestParseErrorPieces(t *testing.T) {
+	tests := []errorPiecesTest{
+		{
+			Description:         "balanced containers",
+			Actual:              `Job "pi" is invalid: [spec.selector: Required value] (reason: Invalid)`,
+			ContainerTypes:      []string{`"`, "[", "("},
+			ExpectedDiagnostics: nil,
+		},
+		{
+			Description:    "brackets within quotes",
+			Actual:         `Invalid value: "a [ b": field is immutable, found 'x(' and ` + "`{`",
+			ContainerTypes: []string{`"`, "'", "`"},
+		},
+		{
+			Description:    "escaped quotes within quotes",
+			Actual:         `Invalid value: "a \" [" [b]`,
+			ContainerTypes: []string{`"`, "["},
+		},
+		{
+			Description:    "apostrophes within words",
+			Actual:         `Value doesn't match [a, b] (isn't 'c')`,
+			ContainerTypes: []string{"[", "("},
+		},
+		{
+			Description:    "unclosed bracket",
+			Actual:         `decode slice: expect [ or n, but found x (reason: BadRequest)`,
+			ContainerTypes: []string{"("},
+			ExpectedDiagnostics: []errors.ErrorPieceDiagnostic{
+				{Offset: 21, Message: "Unclosed '[' treated as text"},
+			},
+		},
+		{
+			Description:    "unexpected closer",
+			Actual:         `Unexpected ] in (value)`,
+			ContainerTypes: []string{"("},
+			ExpectedDiagnostics: []errors.ErrorPieceDiagnostic{
+				{Offset: 11, Message: "Unexpected ']' treated as text"},
+			},
+		},
+		{
+			Description:    "closer of outer container",
+			Actual:         `[a (b] c`,
+			ContainerTypes: []string{"["},
+			ExpectedDiagnostics: []errors.ErrorPieceDiagnostic{
+				{Offset: 3, Message: "Unclosed '(' treated as text"},
+			},
+		},
+		{
+			Description:    "opener taking closer of outer container",
+			Actual:         `[a: expect [ or n, but found "] (b)`,
+			ContainerTypes: []string{"[", "("},
+			ExpectedDiagnostics: []errors.ErrorPieceDiagnostic{
+				{Offset: 11, Message: "Unbalanced '[' treated as text"},
+				{Offset: 29, Message: `Unclosed '"' treated as text`},
+			},
+		},
+		{
+			Description:    "unclosed quote before raw section",
+			Actual:         `but found ", error found in #10 byte of ...|{"ports":"foo","sele|..., bigger context`,
+			ContainerTypes: []string{"...|"},
+			ExpectedDiagnostics: []errors.ErrorPieceDiagnostic{
+				{Offset: 10, Message: `Unclosed '"' treated as text`},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test.Check(t)
+	}
+}
+
+type errorPiecesTest struct {
+	Description         string
+	Actual              string
+	ContainerTypes      []string
+	ExpectedDiagnostics []errors.ErrorPieceDiagnostic
+}
+
+func (e errorPiecesTest) Check(t *testing.T) {
+	rootPiece, diagnostics := errors.ParseErrorPieces(e.Actual)
+
+	if rootPiece.AsString() != e.Actual {
+		t.Fatalf("(%s) expected pieces to preserve string:\n>>>%s<<< vs \n>>>%s<<<",
+			e.Description, rootPiece.AsString(), e.Actual)
+	}
+
+	var containerTypes []string
+	for _, piece := range rootPiece.Pieces {
+		if piece.IsContainer() {
+			containerTypes = append(containerTypes, piece.ContainerType)
+		}
+	}
+
+	if !reflect.DeepEqual(containerTypes, e.ContainerTypes) {
+		t.Fatalf("(%s) expected container types to match: %#v vs %#v",
+			e.Description, containerTypes, e.ContainerTypes)
+	}
+
+	if !reflect.DeepEqual(diagnostics, e.ExpectedDiagnostics) {
+		t.Fatalf("(%s) expected diagnostics to match: %#v vs %#v",
+			e.Description, diagnostics, e.ExpectedDiagnostics)
+	}
+
+	_, complete := errors.NewErrorPiecesFromString(e.Actual)
+	if complete != (len(e.ExpectedDiagnostics) == 0) {
+		t.Fatalf("(%s) expected completeness to match diagnostics", e.Description)
+	}
+}
@@ -0,0 +1,137 @@
+package errors
+
+import (
+	"strings"
+)
+
+// minErrorWrapWidth prevents deeply nested lines from being
+// wrapped into a column of few characters
+const minErrorWrapWidth = 20
+
+// RenderOpts configures rendering of multi-line error messages for terminals
+type RenderOpts struct {
+	// Width wraps lines longer than width (no wrapping if 0);
+	// wrapped lines are indented under their first line
+	Width int
+
+	// ContextFunc styles lines that have nested lines (e.g. dim)
+	ContextFunc func(string, ...interface{}) string
+
+	// CauseFunc styles innermost lines (e.g. bold red)
+	CauseFunc func(string, ...interface{}) string
+}
+
+func (e MultiLineError) Render(opts RenderOpts) string {
+	return RenderErrorBlock(e.Error(), opts)
+}
+
+func (e SemiStructuredError) Render(opts RenderOpts) string {
+	return RenderErrorBlock(e.Error(), opts)
+}
+
+// RenderErrorBlock wraps and styles error message produced by
+// MultiLineError or SemiStructuredError. Nesting level of each line
+// is determined by its indentation (including list bullets).
+func RenderErrorBlock(block string, opts RenderOpts) string {
+	lines := strings.Split(block, "\n")
+	result := make([]string, 0, len(lines))
+
+	for i, line := range lines {
+		if len(strings.TrimSpace(line)) == 0 {
+			result = append(result, line)
+			continue
+		}
+
+		indent, bullet, text := splitErrorLine(line)
+
+		styleFunc := opts.CauseFunc
+		if hasNestedErrorLines(lines[i+1:], len(indent)+len(bullet)) {
+			styleFunc = opts.ContextFunc
+		}
+
+		// Continuation of list items is aligned with item text
+		hangingIndent := indent + strings.Repeat(" ", len(bullet))
+		if len(bullet) == 0 {
+			hangingIndent += "  "
+		}
+
+		chunks := []string{text}
+		if opts.Width > 0 {
+			chunks = wrapErrorLine(text, opts.Width-len(indent)-len(bullet), opts.Width-len(hangingIndent))
+		}
+
+		for j, chunk := range chunks {
+			if styleFunc != nil {
+				chunk = styleFunc("%s", chunk)
+			}
+			if j == 0 {
+				result = append(result, indent+bullet+chunk)
+			} else {
+				result = append(result, hangingIndent+chunk)
+			}
+		}
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func splitErrorLine(line string) (string, string, string) {
+	text := strings.TrimLeft(line, " ")
+	indent := line[:len(line)-len(text)]
+
+	if strings.HasPrefix(text, "- ") {
+		return indent, "- ", text[2:]
+	}
+
+	return indent, "", text
+}
+
+// hasNestedErrorLines checks if following non-empty line
+// is indented further than text of a current line
+func hasNestedErrorLines(lines []string, textIndent int) bool {
+	for _, line := range lines {
+		if len(strings.TrimSpace(line)) > 0 {
+			return len(line)-len(strings.TrimLeft(line, " ")) > textIndent
+		}
+	}
+	return false
+}
+
+// wrapErrorLine breaks text at spaces (or within words that do not fit)
+func wrapErrorLine(text string, firstWidth, width int) []string {
+	var result []string
+
+	runes := []rune(text)
+	lineWidth := firstWidth
+
+	for {
+		if lineWidth < minErrorWrapWidth {
+			lineWidth = minErrorWrapWidth
+		}
+
+		if len(runes) <= lineWidth {
+			return append(result, string(runes))
+		}
+
+		breakIdx, nextIdx := lineWidth, lineWidth
+
+		for i := lineWidth; i > 0; i-- {
+			if runes[i] == ' ' {
+				breakIdx, nextIdx = i, i+1
+				break
+			}
+		}
+
+		result = append(result, strings.TrimRight(string(runes[:breakIdx]), " "))
+
+		for nextIdx < len(runes) && runes[nextIdx] == ' ' {
+			nextIdx++
+		}
+		if nextIdx == len(runes) {
+			return result
+		}
+
+		runes = runes[nextIdx:]
+		lineWidth = width
+	}
+}
@@ -0,0 +1,135 @@
+package errors_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cppforlife/go-cli-ui/errors"
+)
+
+func TestRenderErrorBlock(t *testing.T) {
+	contextFunc := func(pattern string, args ...interface{}) string { return "<" + fmt.Sprintf(pattern, args...) + ">" }
+	causeFunc := func(pattern string, args ...interface{}) string { return "*" + fmt.Sprintf(pattern, args...) + "*" }
+
+	tests := []renderErrorBlockTest{
+		{
+			Description: "without width lines are not wrapped",
+			Actual:      "Applying:\n  Creating:\n    Service in version \"v1\" cannot be handled as a Service",
+			Expected: `
+Applying:
+  Creating:
+    Service in version "v1" cannot be handled as a Service
+`,
+		},
+		{
+			Description: "long lines are wrapped with indentation under their first line",
+			Actual:      "Applying deployment/frontend:\n  Creating deployment/frontend:\n    Service in version \"v1\" cannot be handled as a Service",
+			Opts:        errors.RenderOpts{Width: 30},
+			Expected: `
+Applying deployment/frontend:
+  Creating
+    deployment/frontend:
+    Service in version "v1"
+      cannot be handled as a
+      Service
+`,
+		},
+		{
+			Description: "list items are wrapped aligned with item text",
+			Actual:      "Job is invalid:\n\n  - spec.selector: Required value for selector\n\n  - spec.template: Invalid value",
+			Opts:        errors.RenderOpts{Width: 30},
+			Expected: `
+Job is invalid:
+
+  - spec.selector: Required
+    value for selector
+
+  - spec.template: Invalid
+    value
+`,
+		},
+		{
+			Description: "words that do not fit are broken",
+			Actual:      "Invalid value: core.PodTemplateSpec{ObjectMeta:v1.ObjectMeta{Name:\"\"}}",
+			Opts:        errors.RenderOpts{Width: 25},
+			Expected: `
+Invalid value:
+  core.PodTemplateSpec{Ob
+  jectMeta:v1.ObjectMeta{
+  Name:""}}
+`,
+		},
+		{
+			Description: "deeply nested lines are wrapped to minimum width",
+			Actual:      strings.Repeat(" ", 30) + "Expected number of matched nodes to be 1",
+			Opts:        errors.RenderOpts{Width: 40},
+			Expected: `
+                              Expected number of
+                                matched nodes to be
+                                1
+`,
+		},
+		{
+			Description: "lines with nested lines are styled as context",
+			Actual:      "Applying:\n  Validating:\n\n    - Invalid name\n\n    - Checking port:\n        Invalid\n        port\n (reason: Invalid)",
+			Opts:        errors.RenderOpts{ContextFunc: contextFunc, CauseFunc: causeFunc},
+			Expected: `
+<Applying:>
+  <Validating:>
+
+    - *Invalid name*
+
+    - <Checking port:>
+        *Invalid*
+        *port*
+ *(reason: Invalid)*
+`,
+		},
+		{
+			Description: "wrapped lines are styled separately",
+			Actual:      "Applying:\n  Service cannot be handled as a Service",
+			Opts:        errors.RenderOpts{Width: 25, ContextFunc: contextFunc, CauseFunc: causeFunc},
+			Expected: `
+<Applying:>
+  *Service cannot be*
+    *handled as a Service*
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test.Check(t)
+	}
+}
+
+func TestMultiLineErrorRender(t *testing.T) {
+	err := fmt.Errorf("Applying: %w", fmt.Errorf("Creating: %w", fmt.Errorf("Invalid value")))
+
+	actual := errors.NewMultiLineError(err).Render(errors.RenderOpts{
+		CauseFunc: func(pattern string, args ...interface{}) string { return "*" + fmt.Sprintf(pattern, args...) + "*" },
+	})
+
+	expected := "Applying:\n  Creating:\n    *Invalid value*"
+
+	if actual != expected {
+		t.Fatalf("expected error to match:\n>>>%s<<< vs \n>>>%s<<<", actual, expected)
+	}
+}
+
+type renderErrorBlockTest struct {
+	Description string
+	Actual      string
+	Opts        errors.RenderOpts
+	Expected    string
+}
+
+func (e renderErrorBlockTest) Check(t *testing.T) {
+	actual := errors.RenderErrorBlock(e.Actual, e.Opts)
+	e.Expected = strings.Trim(e.Expected, "\n")
+
+	if actual != e.Expected {
+		t.Fatalf("(%s) expected error to match:\n%d chars >>>%s<<< vs \n%d chars >>>%s<<<",
+			e.Description, len(actual), actual, len(e.Expected), e.Expected)
+	}
+}
@@ -0,0 +1,170 @@
+package errors
+
+import (
+	goerrors "errors"
+	"fmt"
+	"strings"
+)
+
+// maxMultiErrorItems limits number of shown errors of a multi-error
+const maxMultiErrorItems = 10
+
+// multiError is implemented by errors that wrap
+// multiple errors (e.g. errors.Join in Go 1.20+)
+type multiError interface {
+	Unwrap() []error
+}
+
+// errorTreeRenderer indents wrapped errors under their context and
+// shows multi-errors as lists. Wrapping errors are only followed
+// while their messages include messages of wrapped errors.
+type errorTreeRenderer struct {
+	// structured is set once wrapping information was used
+	structured bool
+}
+
+// renderErrorTree returns false if error does not wrap other errors
+func renderErrorTree(err error) (string, bool) {
+	renderer := &errorTreeRenderer{}
+	lines := renderer.render(err)
+
+	if !renderer.structured {
+		return "", false
+	}
+
+	return strings.Join(trimLeadingEmptyLines(lines), "\n"), true
+}
+
+func (r *errorTreeRenderer) render(err error) []string {
+	msg := err.Error()
+
+	if multiErr, ok := err.(multiError); ok {
+		if lines, ok := r.renderMulti(msg, multiErr.Unwrap()); ok {
+			return lines
+		}
+	} else if inner := goerrors.Unwrap(err); inner != nil {
+		if lines, ok := r.renderWrapped(msg, inner); ok {
+			return lines
+		}
+	}
+
+	return strings.Split(msg, "\n")
+}
+
+// renderWrapped shows context (e.g. fmt.Errorf("Context: %w (suffix)", err))
+// above wrapped error; suffix is kept at the end of wrapped error message
+func (r *errorTreeRenderer) renderWrapped(msg string, inner error) ([]string, bool) {
+	innerMsg := inner.Error()
+
+	idx := strings.LastIndex(msg, innerMsg)
+	if idx < 0 || len(innerMsg) == 0 {
+		return nil, false
+	}
+
+	context := strings.TrimRight(msg[:idx], " ")
+
+	lines := r.render(inner)
+	lines[len(lines)-1] += msg[idx+len(innerMsg):]
+
+	// Wrapping errors may not add any context
+	if len(context) == 0 {
+		return lines, true
+	}
+
+	r.structured = true
+
+	return append([]string{context}, indentErrorLines(lines, "  ", "  ")...), true
+}
+
+// renderMulti shows each error as a list item (similar to ErrorPiece.AsString)
+func (r *errorTreeRenderer) renderMulti(msg string, errs []error) ([]string, bool) {
+	var items []error
+
+	for _, err := range errs {
+		if err != nil {
+			items = append(items, err)
+		}
+	}
+
+	if len(items) == 0 {
+		return nil, false
+	}
+
+	idx := strings.Index(msg, items[0].Error())
+	if idx < 0 {
+		return nil, false
+	}
+
+	r.structured = true
+
+	var lines []string
+
+	if context := strings.TrimRight(msg[:idx], " \n"); len(context) > 0 {
+		lines = append(lines, context)
+	}
+
+	// Items are separated by empty lines (including from preceding context)
+	for i, item := range items {
+		lines = append(lines, "")
+
+		if i == maxMultiErrorItems {
+			lines = append(lines, fmt.Sprintf("  - ... and %d more", len(items)-i))
+			break
+		}
+
+		// Nested lists are dedented to align with item bullets
+		itemLines := dedentErrorLines(trimLeadingEmptyLines(r.render(item)))
+		lines = append(lines, indentErrorLines(itemLines, "  - ", "    ")...)
+	}
+
+	return lines, true
+}
+
+func indentErrorLines(lines []string, firstIndent, indent string) []string {
+	result := make([]string, len(lines))
+
+	for i, line := range lines {
+		switch {
+		case len(line) == 0:
+			continue
+		case i == 0:
+			result[i] = firstIndent + line
+		default:
+			result[i] = indent + line
+		}
+	}
+
+	return result
+}
+
+func dedentErrorLines(lines []string) []string {
+	minIndent := -1
+
+	for _, line := range lines {
+		if len(line) > 0 {
+			indent := len(line) - len(strings.TrimLeft(line, " "))
+			if minIndent < 0 || indent < minIndent {
+				minIndent = indent
+			}
+		}
+	}
+
+	if minIndent <= 0 {
+		return lines
+	}
+
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		if len(line) > 0 {
+			result[i] = line[minIndent:]
+		}
+	}
+	return result
+}
+
+func trimLeadingEmptyLines(lines []string) []string {
+	for len(lines) > 1 && len(lines[0]) == 0 {
+		lines = lines[1:]
+	}
+	return lines
+}
@@ -0,0 +1,138 @@
+package errors
+
+import (
+	"strings"
+)
+
+// fieldErrorNode groups listed errors (e.g. from k8s) by field path
+// so that all errors for a field (and its nested fields) are shown together
+type fieldErrorNode struct {
+	// path is relative to parent node (empty for errors without field path)
+	path     string
+	messages []string
+	children []*fieldErrorNode
+}
+
+func newFieldErrorTree(itemPieces []*ErrorPiece) *fieldErrorNode {
+	root := &fieldErrorNode{}
+
+	for _, itemPiece := range itemPieces {
+		item := strings.TrimSpace(itemPiece.AsString())
+
+		if match := errFieldPath.FindStringSubmatch(item); match != nil {
+			root.add(splitFieldPath(match[1]), item[len(match[0]):])
+		} else {
+			// Errors without field path are kept in their position
+			root.children = append(root.children, &fieldErrorNode{messages: []string{item}})
+		}
+	}
+
+	for _, child := range root.children {
+		child.compact()
+	}
+
+	return root
+}
+
+func (n *fieldErrorNode) add(path []string, msg string) {
+	if len(path) == 0 {
+		for _, existingMsg := range n.messages {
+			if existingMsg == msg {
+				return
+			}
+		}
+		n.messages = append(n.messages, msg)
+		return
+	}
+
+	for _, child := range n.children {
+		if len(child.path) > 0 && child.path == path[0] {
+			child.add(path[1:], msg)
+			return
+		}
+	}
+
+	child := &fieldErrorNode{path: path[0]}
+	n.children = append(n.children, child)
+	child.add(path[1:], msg)
+}
+
+// compact merges fields without errors into their only nested field
+// (e.g. spec -> template -> metadata becomes spec.template.metadata)
+func (n *fieldErrorNode) compact() {
+	for len(n.messages) == 0 && len(n.children) == 1 {
+		child := n.children[0]
+		n.path += "." + child.path
+		n.messages = child.messages
+		n.children = child.children
+	}
+
+	for _, child := range n.children {
+		child.compact()
+	}
+}
+
+// AsString formats top level nodes the same way as ErrorPiece.AsString formats lists
+func (n *fieldErrorNode) AsString() string {
+	var result string
+
+	if len(n.children) > 0 {
+		result += "\n\n"
+	}
+	for _, child := range n.children {
+		result += strings.Join(indentErrorLines(child.lines(), "  - ", "    "), "\n") + "\n\n"
+	}
+
+	return result
+}
+
+func (n *fieldErrorNode) lines() []string {
+	var header string
+	var items [][]string
+
+	switch {
+	case len(n.path) == 0:
+		header = n.messages[0]
+	case len(n.messages) == 1:
+		header = n.path + ": " + n.messages[0]
+	default:
+		header = n.path + ":"
+		for _, msg := range n.messages {
+			items = append(items, strings.Split(msg, "\n"))
+		}
+	}
+
+	for _, child := range n.children {
+		items = append(items, child.lines())
+	}
+
+	lines := strings.Split(header, "\n")
+
+	for _, item := range items {
+		lines = append(lines, "")
+		lines = append(lines, indentErrorLines(item, "  - ", "    ")...)
+	}
+
+	return lines
+}
+
+// splitFieldPath splits path by dots outside of brackets
+// (e.g. metadata.annotations[kapp.k14s.io/app])
+func splitFieldPath(path string) []string {
+	var result []string
+	var depth, start int
+
+	for i, ch := range path {
+		switch {
+		case ch == '[':
+			depth++
+		case ch == ']':
+			depth--
+		case ch == '.' && depth == 0:
+			result = append(result, path[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(result, path[start:])
+}
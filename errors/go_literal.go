@@ -0,0 +1,201 @@
+package errors
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	defaultElisionMaxLen = 300
+
+	elisionMarker = "\x00"
+)
+
+var (
+	// Zero values as printed via %#v (e.g. Name:"", Labels:map[string]string(nil), Time:v1.Time{})
+	goLiteralZeroField = regexp.MustCompile(`^[A-Za-z_]\w*:(?:""|0|0x0|false|nil|<nil>|[\w.\[\]*()]*\(nil\)|[\w.\[\]*]*\{\})$`)
+	goLiteralFieldSep  = ", "
+)
+
+// ElisionOpts configures collapsing of Go-syntax literals
+// (e.g. core.PodTemplateSpec{...} printed via %#v) within errors
+type ElisionOpts struct {
+	// MaxLen truncates literals longer than MaxLen
+	// after zero fields are removed (defaults to 300)
+	MaxLen int
+
+	// Verbose shows literals without any changes
+	Verbose bool
+}
+
+// ElidedError collapses Go-syntax literals included in error message
+// by removing zero fields and truncating long literals
+type ElidedError struct {
+	err  error
+	opts ElisionOpts
+}
+
+func NewElidedError(err error, opts ElisionOpts) ElidedError {
+	return ElidedError{err, opts}
+}
+
+func (e ElidedError) Error() (result string) {
+	// Elision is only cosmetic so show message as is if it fails
+	defer func() {
+		if rec := recover(); rec != nil {
+			result = e.err.Error()
+		}
+	}()
+
+	if e.opts.Verbose {
+		return e.err.Error()
+	}
+
+	rootPiece, _ := ParseErrorPieces(e.err.Error())
+	rootPiece.ElideGoLiterals(e.opts)
+
+	return rootPiece.AsString()
+}
+
+func (e ElidedError) Unwrap() error { return e.err }
+
+// ElideGoLiterals collapses curly containers that are preceded
+// by a type name (e.g. v1.ObjectMeta{...}, map[string]string{...})
+func (p *ErrorPiece) ElideGoLiterals(opts ElisionOpts) {
+	if opts.Verbose {
+		return
+	}
+	if opts.MaxLen <= 0 {
+		opts.MaxLen = defaultElisionMaxLen
+	}
+
+	for i, piece := range p.Pieces {
+		switch {
+		case i > 0 && piece.isGoLiteral(p.Pieces[i-1]):
+			inner := piece.collapseGoLiteral()
+			if len(inner)+2 > opts.MaxLen {
+				inner = truncateGoLiteral(inner, opts.MaxLen)
+			}
+			piece.Pieces = []*ErrorPiece{{Value: inner}}
+
+		case piece.IsContainer() && !piece.IsLeafContainer():
+			piece.ElideGoLiterals(opts)
+		}
+	}
+}
+
+func (p *ErrorPiece) isGoLiteral(prevPiece *ErrorPiece) bool {
+	if p.ContainerType != curlyOpen || !p.closed || prevPiece.IsContainer() {
+		return false
+	}
+	val := prevPiece.Value
+	return len(val) > 0 && (isWordChar(val[len(val)-1]) || val[len(val)-1] == '_')
+}
+
+// collapseGoLiteral returns literal contents without zero fields
+// (literals nested within it are collapsed as well)
+func (p *ErrorPiece) collapseGoLiteral() string {
+	fields := []string{""}
+
+	for i, piece := range p.Pieces {
+		switch {
+		case len(piece.Value) > 0:
+			for j, val := range strings.Split(piece.Value, goLiteralFieldSep) {
+				if j > 0 {
+					fields = append(fields, "")
+				}
+				fields[len(fields)-1] += val
+			}
+
+		case i > 0 && piece.isGoLiteral(p.Pieces[i-1]):
+			fields[len(fields)-1] += curlyOpen + piece.collapseGoLiteral() + curlyClose
+
+		default:
+			fields[len(fields)-1] += piece.AsString()
+		}
+	}
+
+	var result []string
+
+	for _, field := range fields {
+		if !goLiteralZeroField.MatchString(strings.TrimSpace(field)) {
+			result = append(result, field)
+		}
+	}
+
+	return strings.Join(result, goLiteralFieldSep)
+}
+
+// truncateGoLiteral keeps brackets and quotes balanced
+// so that result could be parsed again (e.g. by SemiStructuredError)
+func truncateGoLiteral(inner string, maxLen int) string {
+	rootPiece, _ := ParseErrorPieces(inner)
+
+	budget := maxLen - 2
+	result := rootPiece.truncate(&budget)
+
+	idx := strings.Index(result, elisionMarker)
+	if idx < 0 {
+		return inner
+	}
+
+	// Only closers of truncated containers follow the marker
+	kept, closers := strings.TrimRight(result[:idx], " ,"), result[idx+len(elisionMarker):]
+	elidedLen := len(inner) - len(kept) - len(closers)
+
+	return kept + fmt.Sprintf("... (elided %d chars)", elidedLen) + closers
+}
+
+// truncate renders piece until budget is used up; quoted and
+// raw pieces are not split and containers are always closed
+func (p *ErrorPiece) truncate(budget *int) string {
+	if len(p.Value) > 0 {
+		if len(p.Value) <= *budget {
+			*budget -= len(p.Value)
+			return p.Value
+		}
+		val := truncateRunes(p.Value, *budget)
+		*budget = -1
+		return val + elisionMarker
+	}
+
+	if p.IsLeafContainer() {
+		str := p.AsString()
+		if len(str) <= *budget {
+			*budget -= len(str)
+			return str
+		}
+		*budget = -1
+		return elisionMarker
+	}
+
+	result := sepOpeners[p.ContainerType]
+	if len(result) > *budget {
+		*budget = -1
+		return elisionMarker
+	}
+	*budget -= len(result)
+
+	for _, piece := range p.Pieces {
+		if *budget < 0 {
+			break
+		}
+		result += piece.truncate(budget)
+	}
+
+	if p.closed {
+		result += sepClosers[p.ContainerType]
+	}
+
+	return result
+}
+
+// truncateRunes returns at most n bytes without splitting runes
+func truncateRunes(str string, n int) string {
+	for n > 0 && n < len(str) && !utf8.RuneStart(str[n]) {
+		n--
+	}
+	return str[:n]
+}
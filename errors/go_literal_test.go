@@ -0,0 +1,84 @@
+package errors_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cppforlife/go-cli-ui/errors"
+)
+
+func TestElidedError(t *testing.T) {
+	tests := []elidedErrorTest{
+		{
+			Description: "zero fields are removed",
+			Actual:      `Invalid value: v1.ObjectMeta{Name:"app", Namespace:"", Generation:0, CreationTimestamp:v1.Time{Time:time.Time{wall:0x0, ext:0, loc:(*time.Location)(nil)}}, DeletionTimestamp:(*v1.Time)(nil), Labels:map[string]string{"app":"guestbook"}, Annotations:map[string]string(nil), Finalizers:[]string(nil)}: field is immutable`,
+			Expected:    `Invalid value: v1.ObjectMeta{Name:"app", Labels:map[string]string{"app":"guestbook"}}: field is immutable`,
+		},
+		{
+			Description: "literals with only zero fields are kept empty",
+			Actual:      `Invalid value: core.ResourceRequirements{Limits:core.ResourceList(nil), Requests:core.ResourceList(nil)}`,
+			Expected:    `Invalid value: core.ResourceRequirements{}`,
+		},
+		{
+			Description: "long literals are truncated keeping brackets and quotes balanced",
+			Actual:      `Invalid value: core.Container{Name:"pi", Image:"perl", Command:[]string{"perl", "-Mbignum=bpi", "-wle", "print bpi(2000)"}, TerminationMessagePath:"/dev/termination-log"}: field is immutable`,
+			Opts:        errors.ElisionOpts{MaxLen: 60},
+			Expected:    `Invalid value: core.Container{Name:"pi", Image:"perl", Command:[]string{"perl"... (elided 90 chars)}}: field is immutable`,
+		},
+		{
+			Description: "non-Go curly brackets are kept",
+			Actual:      `Decoding: {"name":"", "count":0} (reason: BadRequest)`,
+			Expected:    `Decoding: {"name":"", "count":0} (reason: BadRequest)`,
+		},
+		{
+			Description: "verbose shows literals as is",
+			Actual:      `Invalid value: v1.ObjectMeta{Name:"app", Namespace:""}`,
+			Opts:        errors.ElisionOpts{Verbose: true},
+			Expected:    `Invalid value: v1.ObjectMeta{Name:"app", Namespace:""}`,
+		},
+		{
+			Description: "malformed messages keep complete literals collapsed",
+			Actual:      `Invalid value: v1.ObjectMeta{Name:"app", Namespace:""} (reason: Invalid`,
+			Expected:    `Invalid value: v1.ObjectMeta{Name:"app"} (reason: Invalid`,
+		},
+	}
+
+	for _, test := range tests {
+		test.Check(t)
+	}
+}
+
+func TestElidedErrorWithSemiStructuredError(t *testing.T) {
+	err := errors.NewElidedError(fmt.Errorf(`Job.batch "pi" is invalid: [spec.selector: Required value, spec.template: Invalid value: core.PodTemplateSpec{ObjectMeta:v1.ObjectMeta{Name:"", Labels:map[string]string{"app":"pi"}}}: field is immutable] (reason: Invalid)`), errors.ElisionOpts{})
+
+	expected := strings.TrimSpace(`
+Job.batch "pi" is invalid: 
+
+  - spec.selector: Required value
+
+  - spec.template: Invalid value: core.PodTemplateSpec{ObjectMeta:v1.ObjectMeta{Labels:map[string]string{"app":"pi"}}}: field is immutable
+
+ (reason: Invalid)
+`)
+
+	if actual := errors.NewSemiStructuredError(err).Error(); actual != expected {
+		t.Fatalf("expected error to match:\n>>>%s<<< vs \n>>>%s<<<", actual, expected)
+	}
+}
+
+type elidedErrorTest struct {
+	Description string
+	Actual      string
+	Opts        errors.ElisionOpts
+	Expected    string
+}
+
+func (e elidedErrorTest) Check(t *testing.T) {
+	actual := errors.NewElidedError(fmt.Errorf("%s", e.Actual), e.Opts).Error()
+
+	if actual != e.Expected {
+		t.Fatalf("(%s) expected error to match:\n%d chars >>>%s<<< vs \n%d chars >>>%s<<<",
+			e.Description, len(actual), actual, len(e.Expected), e.Expected)
+	}
+}
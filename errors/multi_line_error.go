@@ -9,12 +9,23 @@ var (
 	errColonSep = regexp.MustCompile("(: )([A-Z])")
 )
 
+type MultiLineErrorOpts struct {
+	// FormatPayloads shows JSON objects included in
+	// error message (e.g. response bodies) as indented blocks
+	FormatPayloads bool
+}
+
 type MultiLineError struct {
-	err error
+	err  error
+	opts MultiLineErrorOpts
 }
 
 func NewMultiLineError(err error) MultiLineError {
-	return MultiLineError{err}
+	return MultiLineError{err: err}
+}
+
+func NewMultiLineErrorWithOpts(err error, opts MultiLineErrorOpts) MultiLineError {
+	return MultiLineError{err: err, opts: opts}
 }
 
 func (e MultiLineError) Error() (result string) {
@@ -26,6 +37,11 @@ func (e MultiLineError) Error() (result string) {
 		}
 	}()
 
+	// Prefer precise structure of wrapped errors when it's available
+	if result, ok := renderErrorTree(e.err); ok {
+		return e.formatPayloads(result)
+	}
+
 	lines := strings.Split(e.err.Error(), "\n")
 
 	firstLineRootPiece, _ := NewErrorPiecesFromString(lines[0])
@@ -44,5 +60,12 @@ func (e MultiLineError) Error() (result string) {
 
 	lines[0] = strings.Join(firstLines, "\n")
 
-	return strings.Join(lines, "\n")
+	return e.formatPayloads(strings.Join(lines, "\n"))
+}
+
+func (e MultiLineError) formatPayloads(msg string) string {
+	if e.opts.FormatPayloads {
+		return formatErrorPayloads(msg)
+	}
+	return msg
 }
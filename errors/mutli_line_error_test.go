@@ -44,8 +44,7 @@ Applying create deployment/frontend (apps/v1) namespace: default:
 `,
 		},
 		{
-			// TODO may be deal with this better
-			Description: "uneven bracing",
+			Description: "uneven bracing is kept as text",
 			Actual:      `Applying create service/redis-master (v1) namespace: default: Creating resource service/redis-master (v1) namespace: default: Service in version "v1" cannot be handled as a Service: v1.Service.Spec: v1.ServiceSpec.Ports: []v1.ServicePort: decode slice: expect [ or n, but found ", error found in #10 byte of ...|{"ports":"foo","sele|..., bigger context ...|s-master","namespace":"default"},"spec":{"ports":"foo","selector":{"app":"redis","kapp.k14s.io/app":|... (reason: BadRequest)`,
 			Expected: `
 Applying create service/redis-master (v1) namespace: default:
@@ -71,6 +70,195 @@ Overlaying data values (in following order: values.yml, values.yml, additional d
 	}
 }
 
+func TestMultiLineErrorWithWrappedErrors(t *testing.T) {
+	leafErr := fmt.Errorf(`spec.selector: Invalid value: "null": field is immutable`)
+
+	tests := []wrappedMultiLineErrorTest{
+		{
+			Description: "wrapped errors are indented under their context",
+			Actual: fmt.Errorf("Applying create deployment/frontend (apps/v1): %w",
+				fmt.Errorf("Creating resource deployment/frontend (apps/v1): %w", leafErr)),
+			Expected: `
+Applying create deployment/frontend (apps/v1):
+  Creating resource deployment/frontend (apps/v1):
+    spec.selector: Invalid value: "null": field is immutable
+`,
+		},
+		{
+			Description: "context with multiple capitalized parts stays on one line",
+			Actual:      fmt.Errorf("Applying: Creating: %w", leafErr),
+			Expected: `
+Applying: Creating:
+  spec.selector: Invalid value: "null": field is immutable
+`,
+		},
+		{
+			Description: "suffixes are kept after innermost error",
+			Actual: fmt.Errorf("Applying: %w (reason: Invalid)",
+				fmt.Errorf("Creating: %w (attempt 2)", leafErr)),
+			Expected: `
+Applying:
+  Creating:
+    spec.selector: Invalid value: "null": field is immutable (attempt 2) (reason: Invalid)
+`,
+		},
+		{
+			Description: "wrapping errors without context are skipped",
+			Actual:      fmt.Errorf("Applying: %w", transparentErr{fmt.Errorf("Creating: %w", leafErr)}),
+			Expected: `
+Applying:
+  Creating:
+    spec.selector: Invalid value: "null": field is immutable
+`,
+		},
+		{
+			Description: "wrapping errors that hide wrapped messages end the chain",
+			Actual:      fmt.Errorf("Applying: %w", hidingErr{fmt.Errorf("Creating: %w", leafErr)}),
+			Expected: `
+Applying:
+  Failed to create
+`,
+		},
+		{
+			Description: "errors without context fall back to parsing messages",
+			Actual:      transparentErr{fmt.Errorf("Applying: Creating: Invalid value")},
+			Expected: `
+Applying:
+  Creating:
+    Invalid value
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test.Check(t)
+	}
+}
+
+func TestMultiLineErrorWithMultiErrors(t *testing.T) {
+	var manyErrs joinedErr
+	for i := 1; i <= 12; i++ {
+		manyErrs = append(manyErrs, fmt.Errorf("Error %d", i))
+	}
+
+	tests := []wrappedMultiLineErrorTest{
+		{
+			Description: "joined errors are shown as a list",
+			Actual:      joinedErr{fmt.Errorf("Invalid name"), nil, fmt.Errorf("Invalid\nport")},
+			Expected: `
+  - Invalid name
+
+  - Invalid
+    port
+`,
+		},
+		{
+			Description: "wrapped joined errors are shown under their context",
+			Actual: fmt.Errorf("Applying: %w", fmt.Errorf("Validating: %w",
+				joinedErr{fmt.Errorf("Invalid name"), fmt.Errorf("Checking port: %w", fmt.Errorf("Invalid port"))})),
+			Expected: `
+Applying:
+  Validating:
+
+      - Invalid name
+
+      - Checking port:
+          Invalid port
+`,
+		},
+		{
+			Description: "nested joined errors are shown as nested lists",
+			Actual: joinedErr{
+				fmt.Errorf("Invalid name"),
+				joinedErr{fmt.Errorf("Invalid port"), fmt.Errorf("Invalid host")},
+			},
+			Expected: `
+  - Invalid name
+
+  - - Invalid port
+
+    - Invalid host
+`,
+		},
+		{
+			Description: "large number of errors is capped",
+			Actual:      manyErrs,
+			Expected: `
+  - Error 1
+
+  - Error 2
+
+  - Error 3
+
+  - Error 4
+
+  - Error 5
+
+  - Error 6
+
+  - Error 7
+
+  - Error 8
+
+  - Error 9
+
+  - Error 10
+
+  - ... and 2 more
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test.Check(t)
+	}
+}
+
+// joinedErr is similar to errors.Join
+type joinedErr []error
+
+func (e joinedErr) Error() string {
+	var msgs []string
+	for _, err := range e {
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e joinedErr) Unwrap() []error { return e }
+
+type transparentErr struct {
+	err error
+}
+
+func (e transparentErr) Error() string { return e.err.Error() }
+func (e transparentErr) Unwrap() error { return e.err }
+
+type hidingErr struct {
+	err error
+}
+
+func (e hidingErr) Error() string { return "Failed to create" }
+func (e hidingErr) Unwrap() error { return e.err }
+
+type wrappedMultiLineErrorTest struct {
+	Description string
+	Actual      error
+	Expected    string
+}
+
+func (e wrappedMultiLineErrorTest) Check(t *testing.T) {
+	apiErr := errors.NewMultiLineError(e.Actual)
+	e.Expected = strings.Trim(e.Expected, "\n")
+
+	if apiErr.Error() != e.Expected {
+		t.Fatalf("(%s) expected error to match:\n%d chars >>>%s<<< vs \n%d chars >>>%s<<<",
+			e.Description, len(apiErr.Error()), apiErr, len(e.Expected), e.Expected)
+	}
+}
+
 type multiLineErrorTest struct {
 	Description string
 	Actual      string
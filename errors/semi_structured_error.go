@@ -1,11 +1,30 @@
 package errors
 
+type SemiStructuredErrorOpts struct {
+	// GroupByField groups listed errors by their field paths
+	// (e.g. spec.selector) showing nested fields under their parents
+	GroupByField bool
+
+	// FormatPayloads shows JSON objects included in
+	// error message (e.g. response bodies) as indented blocks
+	FormatPayloads bool
+
+	// ElideGoLiterals collapses Go-syntax literals (e.g. %#v dumps
+	// of k8s objects) within listed errors; literals are kept if nil
+	ElideGoLiterals *ElisionOpts
+}
+
 type SemiStructuredError struct {
-	err error
+	err  error
+	opts SemiStructuredErrorOpts
 }
 
 func NewSemiStructuredError(err error) SemiStructuredError {
-	return SemiStructuredError{err}
+	return SemiStructuredError{err: err}
+}
+
+func NewSemiStructuredErrorWithOpts(err error, opts SemiStructuredErrorOpts) SemiStructuredError {
+	return SemiStructuredError{err: err, opts: opts}
 }
 
 func (e SemiStructuredError) Error() (result string) {
@@ -17,12 +36,14 @@ func (e SemiStructuredError) Error() (result string) {
 		}
 	}()
 
-	rootPiece, complete := NewErrorPiecesFromString(e.err.Error())
-	if !complete {
-		// Cannot deconstruct error message, return original
-		return e.err.Error()
+	msg := e.err.Error()
+	if e.opts.ElideGoLiterals != nil {
+		msg = NewElidedError(e.err, *e.opts.ElideGoLiterals).Error()
 	}
 
+	// Malformed parts (e.g. unbalanced brackets) are kept as text
+	rootPiece, _ := ParseErrorPieces(msg)
+
 	for _, piece := range rootPiece.Pieces {
 		// k8s list of errors is wrapped with [] and separated by comma
 		// (https://github.com/kubernetes/kubernetes/blob/a5e6ac0a959b059513c1e7908fbb0713467839c4/staging/src/k8s.io/apimachinery/pkg/util/errors/errors.go#L64)
@@ -33,5 +54,17 @@ func (e SemiStructuredError) Error() (result string) {
 
 	rootPiece.ReorganizePieces()
 
+	if e.opts.GroupByField {
+		for _, piece := range rootPiece.Pieces {
+			if piece.FormatPiecesAsList {
+				*piece = ErrorPiece{Value: newFieldErrorTree(piece.Pieces).AsString()}
+			}
+		}
+	}
+
+	if e.opts.FormatPayloads {
+		return formatErrorPayloads(rootPiece.AsString())
+	}
+
 	return rootPiece.AsString()
 }
@@ -48,9 +48,34 @@ Job.batch "successful-job" is invalid:
 			Expected:    `Deployment.apps "frontend" is invalid: spec.template.metadata.labels: Invalid value: map[string]string{"app":"guestbook", "kapp.k14s.io/app":"1588343775866234000", "kapp.k14s.io/association":"v1.95c1511bde234f3b1296c5e2be3c6864", "tier":"frontend"}: selector does not match template labels (reason: Invalid)`,
 		},
 		{
-			Description: "structure is not symmetric",
+			Description: "structure is not symmetric (unclosed bracket is kept as text)",
 			Actual:      `Job.batch "pi" is invalid: [spec.selector: Required value, spec.template.metadata.labels: Invalid value: map[string]string{"kapp.k14s.io/app":"1586905796363557000", "kapp.k14s.io/association":"v1.a4db8f96450049336d37eb62d798d883"}: selector does not match template labels, spec.selector: Invalid value: "null": field is immutable, spec.template: Invalid value: core.PodTemplateSpec{ObjectMeta:v1.ObjectMeta{Name:"", GenerateName:"",...: field is immutable] (reason: Invalid)`,
-			Expected:    `Job.batch "pi" is invalid: [spec.selector: Required value, spec.template.metadata.labels: Invalid value: map[string]string{"kapp.k14s.io/app":"1586905796363557000", "kapp.k14s.io/association":"v1.a4db8f96450049336d37eb62d798d883"}: selector does not match template labels, spec.selector: Invalid value: "null": field is immutable, spec.template: Invalid value: core.PodTemplateSpec{ObjectMeta:v1.ObjectMeta{Name:"", GenerateName:"",...: field is immutable] (reason: Invalid)`,
+			Expected: `
+Job.batch "pi" is invalid: 
+
+  - spec.selector: Required value
+
+  - spec.template.metadata.labels: Invalid value: map[string]string{"kapp.k14s.io/app":"1586905796363557000", "kapp.k14s.io/association":"v1.a4db8f96450049336d37eb62d798d883"}: selector does not match template labels
+
+  - spec.selector: Invalid value: "null": field is immutable
+
+  - spec.template: Invalid value: core.PodTemplateSpec{ObjectMeta:v1.ObjectMeta{Name:"", GenerateName:"",...: field is immutable
+
+ (reason: Invalid)
+`,
+		},
+		{
+			Description: "unbalanced bracket within item",
+			Actual:      `Service "a" is invalid: [spec.ports: Required value, spec.x: expect [ or n] (reason: Invalid)`,
+			Expected: `
+Service "a" is invalid: 
+
+  - spec.ports: Required value
+
+  - spec.x: expect [ or n
+
+ (reason: Invalid)
+`,
 		},
 		{
 			Description: "truncated content",
@@ -64,14 +89,117 @@ Job.batch "successful-job" is invalid:
 	}
 }
 
+func TestSemiStructuredErrorGroupedByField(t *testing.T) {
+	opts := errors.SemiStructuredErrorOpts{GroupByField: true}
+
+	tests := []semiStructuredErrorTest{
+		{
+			Description: "items are grouped by field path",
+			Actual:      `Job.batch "pi" is invalid: [spec.selector: Required value, spec.template.metadata.labels: Invalid value: map[string]string{"app":"pi"}: selector does not match template labels, spec.selector: Invalid value: "null": field is immutable, spec.template: Invalid value: core.PodTemplateSpec{Name:"pi"}: field is immutable] (reason: Invalid)`,
+			Opts:        opts,
+			Expected: `
+Job.batch "pi" is invalid: 
+
+  - spec:
+
+      - selector:
+
+          - Required value
+
+          - Invalid value: "null": field is immutable
+
+      - template: Invalid value: core.PodTemplateSpec{Name:"pi"}: field is immutable
+
+          - metadata.labels: Invalid value: map[string]string{"app":"pi"}: selector does not match template labels
+
+ (reason: Invalid)
+`,
+		},
+		{
+			Description: "fields with a single nested field are combined and duplicates are merged",
+			Actual:      `Pod "app" is invalid: [spec.containers[0].image: Required value, spec.containers[0].name: Required value, spec.containers[0].image: Required value] (reason: Invalid)`,
+			Opts:        opts,
+			Expected: `
+Pod "app" is invalid: 
+
+  - spec.containers[0]:
+
+      - image: Required value
+
+      - name: Required value
+
+ (reason: Invalid)
+`,
+		},
+		{
+			Description: "items without field path are kept in their position",
+			Actual:      `Service "redis" is invalid: [metadata.name: Required value, internal error occurred, spec.ports: Required value] (reason: Invalid)`,
+			Opts:        opts,
+			Expected: `
+Service "redis" is invalid: 
+
+  - metadata.name: Required value
+
+  - internal error occurred
+
+  - spec.ports: Required value
+
+ (reason: Invalid)
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test.Check(t)
+	}
+}
+
+func TestSemiStructuredErrorWithElidedGoLiterals(t *testing.T) {
+	tests := []semiStructuredErrorTest{
+		{
+			Description: "Go literals of listed errors are collapsed",
+			Actual:      `Job.batch "pi" is invalid: [spec.selector: Required value, spec.template: Invalid value: core.PodTemplateSpec{ObjectMeta:v1.ObjectMeta{Name:"", Labels:map[string]string{"app":"pi"}}}: field is immutable] (reason: Invalid)`,
+			Opts:        errors.SemiStructuredErrorOpts{ElideGoLiterals: &errors.ElisionOpts{}},
+			Expected: `
+Job.batch "pi" is invalid: 
+
+  - spec.selector: Required value
+
+  - spec.template: Invalid value: core.PodTemplateSpec{ObjectMeta:v1.ObjectMeta{Labels:map[string]string{"app":"pi"}}}: field is immutable
+
+ (reason: Invalid)
+`,
+		},
+		{
+			Description: "Go literals are kept when verbose",
+			Actual:      `Job.batch "pi" is invalid: [spec.selector: Required value, spec.template: Invalid value: core.PodTemplateSpec{Name:""}: field is immutable] (reason: Invalid)`,
+			Opts:        errors.SemiStructuredErrorOpts{ElideGoLiterals: &errors.ElisionOpts{Verbose: true}},
+			Expected: `
+Job.batch "pi" is invalid: 
+
+  - spec.selector: Required value
+
+  - spec.template: Invalid value: core.PodTemplateSpec{Name:""}: field is immutable
+
+ (reason: Invalid)
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test.Check(t)
+	}
+}
+
 type semiStructuredErrorTest struct {
 	Description string
 	Actual      string
+	Opts        errors.SemiStructuredErrorOpts
 	Expected    string
 }
 
 func (e semiStructuredErrorTest) Check(t *testing.T) {
-	apiErr := errors.NewSemiStructuredError(fmt.Errorf("%s", e.Actual))
+	apiErr := errors.NewSemiStructuredErrorWithOpts(fmt.Errorf("%s", e.Actual), e.Opts)
 	e.Expected = strings.TrimSpace(e.Expected)
 
 	if apiErr.Error() != e.Expected {
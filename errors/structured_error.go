@@ -0,0 +1,188 @@
+package errors
+
+import (
+	goerrors "errors"
+	"regexp"
+	"strings"
+)
+
+// StructuredError represents error message structure
+// and could be marshaled to JSON (e.g. for automation)
+type StructuredError struct {
+	// Message is a full error message
+	Message string
+
+	// Context lists what was being done when error happened (outermost first)
+	Context []string `json:",omitempty"`
+
+	// Field is a path of an invalid field for listed errors (e.g. spec.selector)
+	Field string `json:",omitempty"`
+
+	// Detail is an innermost error message without listed errors
+	Detail string
+
+	// Items are errors listed within error (e.g. joined errors)
+	Items []StructuredError `json:",omitempty"`
+
+	// Raw are verbatim input chunks included in error message (e.g. ...|{"a":|...)
+	Raw []string `json:",omitempty"`
+}
+
+var (
+	// k8s field errors start with a field path (e.g. spec.containers[0].image: Required value)
+	errFieldPath = regexp.MustCompile(`^([a-z][\w\-]*(?:\[[^\]]*\])*(?:\.[\w\-]+(?:\[[^\]]*\])*)*): `)
+)
+
+const errContextSep = "\x00"
+
+// NewStructuredError builds structure from wrapped errors (see errors.Unwrap)
+// if they are available; otherwise error message is parsed
+// similarly to MultiLineError and SemiStructuredError
+func NewStructuredError(err error) StructuredError {
+	return newStructuredError(err, false)
+}
+
+// newStructuredError optionally extracts field path (e.g. for listed errors)
+func newStructuredError(err error, withField bool) (result StructuredError) {
+	// Fall back to a plain message (as if error was not wrapped)
+	// so that errors printed as JSON never lose their message
+	defer func() {
+		if rec := recover(); rec != nil {
+			result = StructuredError{Message: err.Error(), Detail: err.Error()}
+		}
+	}()
+
+	_, hasWrapped := renderErrorTree(err)
+
+	result.Message = err.Error()
+	result.addError(err, "", !hasWrapped, withField)
+
+	return result
+}
+
+func (e *StructuredError) addError(err error, suffix string, splitContext, withField bool) {
+	msg := err.Error()
+
+	if multiErr, ok := err.(multiError); ok {
+		var items []error
+		for _, item := range multiErr.Unwrap() {
+			if item != nil {
+				items = append(items, item)
+			}
+		}
+
+		if len(items) > 0 {
+			if idx := strings.Index(msg, items[0].Error()); idx >= 0 {
+				e.Detail = trimErrorContext(msg[:idx]) + suffix
+				for _, item := range items {
+					e.Items = append(e.Items, newStructuredError(item, true))
+				}
+				return
+			}
+		}
+	} else if inner := goerrors.Unwrap(err); inner != nil {
+		innerMsg := inner.Error()
+
+		if idx := strings.LastIndex(msg, innerMsg); idx >= 0 && len(innerMsg) > 0 {
+			if context := trimErrorContext(msg[:idx]); len(context) > 0 {
+				e.Context = append(e.Context, context)
+			}
+			e.addError(inner, msg[idx+len(innerMsg):]+suffix, splitContext, withField)
+			return
+		}
+	}
+
+	e.addMessage(msg+suffix, splitContext, withField && len(e.Context) == 0)
+}
+
+// addMessage splits message into context (only if requested)
+// and detail; bracketed lists (e.g. from k8s) become items
+func (e *StructuredError) addMessage(msg string, splitContext, withField bool) {
+	if match := errFieldPath.FindStringSubmatch(msg); withField && match != nil {
+		e.Field = match[1]
+		msg = msg[len(match[0]):]
+	}
+
+	// Malformed parts (e.g. unbalanced brackets) are kept as text
+	rootPiece, diagnostics := ParseErrorPieces(msg)
+
+	e.Raw = append(e.Raw, rawErrorPieces(rootPiece)...)
+
+	// Context is not split after malformed parts since their
+	// extent (e.g. where unclosed list ends) is not known
+	splitEnd := len(msg)
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Offset < splitEnd {
+			splitEnd = diagnostic.Offset
+		}
+	}
+
+	pieceOffsets := map[*ErrorPiece]int{}
+	offset := 0
+	for _, piece := range rootPiece.Pieces {
+		pieceOffsets[piece] = offset
+		offset += len(piece.AsString())
+	}
+
+	for _, piece := range rootPiece.Pieces {
+		if piece.ContainerType == braketOpen {
+			piece.ReorganizePiecesAroundCommas = true
+		}
+	}
+	rootPiece.ReorganizePieces()
+
+	var text string
+	var afterList bool
+
+	for _, piece := range rootPiece.Pieces {
+		switch {
+		case piece.FormatPiecesAsList:
+			for _, itemPiece := range piece.Pieces {
+				item := StructuredError{Message: strings.TrimSpace(itemPiece.AsString())}
+				item.addMessage(item.Message, false, true)
+				e.Items = append(e.Items, item)
+			}
+			afterList = true
+			continue
+
+		case afterList:
+			// Avoid doubling spaces around removed list
+			text = strings.TrimRight(text, " ")
+			afterList = false
+		}
+
+		if offset := pieceOffsets[piece]; len(piece.Value) > 0 && splitContext && offset < splitEnd {
+			splitLen := len(piece.Value)
+			if offset+splitLen > splitEnd {
+				splitLen = splitEnd - offset
+			}
+			text += errColonSep.ReplaceAllString(piece.Value[:splitLen], ":"+errContextSep+"$2") + piece.Value[splitLen:]
+		} else {
+			text += piece.AsString()
+		}
+	}
+
+	parts := strings.Split(text, errContextSep)
+
+	for _, part := range parts[:len(parts)-1] {
+		e.Context = append(e.Context, trimErrorContext(part))
+	}
+
+	e.Detail = strings.TrimSpace(parts[len(parts)-1])
+}
+
+func trimErrorContext(context string) string {
+	return strings.TrimRight(context, ": \n")
+}
+
+func rawErrorPieces(piece *ErrorPiece) []string {
+	if piece.ContainerType == rawOpen {
+		return []string{piece.AsString()}
+	}
+
+	var result []string
+	for _, childPiece := range piece.Pieces {
+		result = append(result, rawErrorPieces(childPiece)...)
+	}
+	return result
+}
@@ -0,0 +1,141 @@
+package errors_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cppforlife/go-cli-ui/errors"
+)
+
+func TestStructuredError(t *testing.T) {
+	leafErr := fmt.Errorf(`spec.selector: Invalid value: "null": field is immutable`)
+
+	tests := []structuredErrorTest{
+		{
+			Description: "wrapped errors",
+			Actual: fmt.Errorf("Applying create deployment/frontend: %w",
+				fmt.Errorf("Creating resource: %w (reason: Invalid)", leafErr)),
+			Expected: errors.StructuredError{
+				Message: `Applying create deployment/frontend: Creating resource: spec.selector: Invalid value: "null": field is immutable (reason: Invalid)`,
+				Context: []string{"Applying create deployment/frontend", "Creating resource"},
+				Detail:  `spec.selector: Invalid value: "null": field is immutable (reason: Invalid)`,
+			},
+		},
+		{
+			Description: "joined errors",
+			Actual:      fmt.Errorf("Validating: %w", joinedErr{leafErr, fmt.Errorf("Checking: %w", fmt.Errorf("Invalid port"))}),
+			Expected: errors.StructuredError{
+				Message: "Validating: spec.selector: Invalid value: \"null\": field is immutable\nChecking: Invalid port",
+				Context: []string{"Validating"},
+				Items: []errors.StructuredError{
+					{
+						Message: `spec.selector: Invalid value: "null": field is immutable`,
+						Field:   "spec.selector",
+						Detail:  `Invalid value: "null": field is immutable`,
+					},
+					{
+						Message: "Checking: Invalid port",
+						Context: []string{"Checking"},
+						Detail:  "Invalid port",
+					},
+				},
+			},
+		},
+		{
+			Description: "message with context and list of errors",
+			Actual:      fmt.Errorf(`Applying create job/pi (batch/v1) namespace: default: Job.batch "pi" is invalid: [spec.selector: Required value, spec.template.spec.restartPolicy: Unsupported value: "Always": supported values: "OnFailure", "Never"] (reason: Invalid)`),
+			Expected: errors.StructuredError{
+				Message: `Applying create job/pi (batch/v1) namespace: default: Job.batch "pi" is invalid: [spec.selector: Required value, spec.template.spec.restartPolicy: Unsupported value: "Always": supported values: "OnFailure", "Never"] (reason: Invalid)`,
+				Context: []string{"Applying create job/pi (batch/v1) namespace: default"},
+				Detail:  `Job.batch "pi" is invalid: (reason: Invalid)`,
+				Items: []errors.StructuredError{
+					{
+						Message: "spec.selector: Required value",
+						Field:   "spec.selector",
+						Detail:  "Required value",
+					},
+					{
+						Message: `spec.template.spec.restartPolicy: Unsupported value: "Always": supported values: "OnFailure", "Never"`,
+						Field:   "spec.template.spec.restartPolicy",
+						Detail:  `Unsupported value: "Always": supported values: "OnFailure", "Never"`,
+					},
+				},
+			},
+		},
+		{
+			Description: "message with unbalanced bracket within list",
+			Actual:      fmt.Errorf(`Service "a" is invalid: [spec.ports: Required value, spec.x: expect [ or n, but found "] (reason: Invalid)`),
+			Expected: errors.StructuredError{
+				Message: `Service "a" is invalid: [spec.ports: Required value, spec.x: expect [ or n, but found "] (reason: Invalid)`,
+				Detail:  `Service "a" is invalid: (reason: Invalid)`,
+				Items: []errors.StructuredError{
+					{
+						Message: "spec.ports: Required value",
+						Field:   "spec.ports",
+						Detail:  "Required value",
+					},
+					{
+						Message: "spec.x: expect [ or n",
+						Field:   "spec.x",
+						Detail:  "expect [ or n",
+					},
+					{
+						Message: `but found "`,
+						Detail:  `but found "`,
+					},
+				},
+			},
+		},
+		{
+			Description: "context is not split after unclosed bracket",
+			Actual:      fmt.Errorf(`Applying: Service "a" is invalid: [spec.ports: Required value, spec.x: Invalid value (reason: Invalid)`),
+			Expected: errors.StructuredError{
+				Message: `Applying: Service "a" is invalid: [spec.ports: Required value, spec.x: Invalid value (reason: Invalid)`,
+				Context: []string{"Applying"},
+				Detail:  `Service "a" is invalid: [spec.ports: Required value, spec.x: Invalid value (reason: Invalid)`,
+			},
+		},
+		{
+			Description: "message with raw chunks",
+			Actual:      fmt.Errorf(`Creating resource: v1.ServicePort.Port: readUint32: unexpected character, error found in #10 byte of ...|[{"port":"6380s","ta|..., bigger context ...|"ports":[{"port":"6380s"|...`),
+			Expected: errors.StructuredError{
+				Message: `Creating resource: v1.ServicePort.Port: readUint32: unexpected character, error found in #10 byte of ...|[{"port":"6380s","ta|..., bigger context ...|"ports":[{"port":"6380s"|...`,
+				Detail:  `Creating resource: v1.ServicePort.Port: readUint32: unexpected character, error found in #10 byte of ...|[{"port":"6380s","ta|..., bigger context ...|"ports":[{"port":"6380s"|...`,
+				Raw:     []string{`...|[{"port":"6380s","ta|...`, `...|"ports":[{"port":"6380s"|...`},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test.Check(t)
+	}
+
+	t.Run("marshals to JSON", func(t *testing.T) {
+		bytes, err := json.Marshal(errors.NewStructuredError(fmt.Errorf("Applying: %w", fmt.Errorf("Invalid port"))))
+		if err != nil {
+			t.Fatalf("Expected marshaling to succeed: %s", err)
+		}
+
+		expected := `{"Message":"Applying: Invalid port","Context":["Applying"],"Detail":"Invalid port"}`
+
+		if string(bytes) != expected {
+			t.Fatalf("Expected JSON to match:\n>>>%s<<< vs \n>>>%s<<<", bytes, expected)
+		}
+	})
+}
+
+type structuredErrorTest struct {
+	Description string
+	Actual      error
+	Expected    errors.StructuredError
+}
+
+func (e structuredErrorTest) Check(t *testing.T) {
+	result := errors.NewStructuredError(e.Actual)
+
+	if !reflect.DeepEqual(result, e.Expected) {
+		t.Fatalf("(%s) expected structured error to match:\n%#v vs \n%#v", e.Description, result, e.Expected)
+	}
+}
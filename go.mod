@@ -7,6 +7,7 @@ require (
 	github.com/mattn/go-isatty v0.0.11
 	github.com/stretchr/testify v1.7.1
 	github.com/vito/go-interact v1.0.1
+	golang.org/x/term v0.0.0-20220411215600-e5f449aeb171
 	sigs.k8s.io/yaml v1.3.0
 )
 
@@ -15,7 +16,6 @@ require (
 	github.com/mattn/go-colorable v0.1.4 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1 // indirect
-	golang.org/x/term v0.0.0-20220411215600-e5f449aeb171 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
 )
@@ -3,22 +3,50 @@ package ui
 import (
 	"github.com/cppforlife/color"
 
+	uierrors "github.com/cppforlife/go-cli-ui/errors"
 	. "github.com/cppforlife/go-cli-ui/ui/table"
 )
 
 type ColorUI struct {
-	parent   UI
-	okFunc   func(string, ...interface{}) string
-	errFunc  func(string, ...interface{}) string
-	boldFunc func(string, ...interface{}) string
+	parent      UI
+	okFunc      func(string, ...interface{}) string
+	errFunc     func(string, ...interface{}) string
+	boldFunc    func(string, ...interface{}) string
+	statusTheme StatusTheme
+
+	// Used for error blocks (see errors.RenderOpts)
+	errContextFunc func(string, ...interface{}) string
+	errCauseFunc   func(string, ...interface{}) string
 }
 
+// StatusTheme maps status levels to color functions
+type StatusTheme map[StatusLevel]func(string, ...interface{}) string
+
 func NewColorUI(parent UI) *ColorUI {
+	return NewColorUIWithStatusTheme(parent, DefaultStatusTheme())
+}
+
+func NewColorUIWithStatusTheme(parent UI, theme StatusTheme) *ColorUI {
 	return &ColorUI{
-		parent:   parent,
-		okFunc:   color.New(color.FgGreen).SprintfFunc(),
-		errFunc:  color.New(color.FgRed).SprintfFunc(),
-		boldFunc: color.New(color.Bold).SprintfFunc(),
+		parent:      parent,
+		okFunc:      color.New(color.FgGreen).SprintfFunc(),
+		errFunc:     color.New(color.FgRed).SprintfFunc(),
+		boldFunc:    color.New(color.Bold).SprintfFunc(),
+		statusTheme: theme,
+
+		errContextFunc: color.New(color.Faint).SprintfFunc(),
+		errCauseFunc:   color.New(color.FgRed, color.Bold).SprintfFunc(),
+	}
+}
+
+func DefaultStatusTheme() StatusTheme {
+	return StatusTheme{
+		StatusOK:         color.New(color.FgGreen).SprintfFunc(),
+		StatusWarning:    color.New(color.FgYellow).SprintfFunc(),
+		StatusError:      color.New(color.FgRed).SprintfFunc(),
+		StatusInfo:       color.New(color.FgCyan).SprintfFunc(),
+		StatusInProgress: color.New(color.FgBlue).SprintfFunc(),
+		StatusUnknown:    color.New(color.Faint).SprintfFunc(),
 	}
 }
 
@@ -47,6 +75,25 @@ func (ui *ColorUI) PrintErrorBlock(block string) {
 }
 
 func (ui *ColorUI) PrintTable(table Table) {
+	ui.parent.PrintTable(ui.colorTable(table))
+}
+
+func (ui *ColorUI) StartLiveTable(table Table) LiveTable {
+	mapFunc := func(table Table) (Table, error) { return ui.colorTable(table), nil }
+	return startMappingLiveTable(ui.parent, table, mapFunc, nil)
+}
+
+func (ui *ColorUI) PrintTableStream(table Table, rows RowIterator, opts StreamOpts) {
+	mapFunc := func(row []Value) []Value {
+		for i, v := range row {
+			row[i] = ui.colorValueFmt(v)
+		}
+		return row
+	}
+	PrintTableStream(ui.parent, ui.colorTable(table), mapRows(rows, mapFunc), opts)
+}
+
+func (ui *ColorUI) colorTable(table Table) Table {
 	table.HeaderFormatFunc = ui.boldFunc
 
 	for k, s := range table.Sections {
@@ -63,7 +110,31 @@ func (ui *ColorUI) PrintTable(table Table) {
 		}
 	}
 
-	ui.parent.PrintTable(table)
+	return table
+}
+
+// PrintError wraps multi-line error to terminal width,
+// dims context lines and highlights innermost causes
+func (ui *ColorUI) PrintError(err error) bool {
+	if forwardError(ui.parent, err) {
+		return true
+	}
+
+	ui.parent.ErrorLinef("%s", uierrors.RenderErrorBlock(errorMessage(err), uierrors.RenderOpts{
+		Width:       TerminalWidth(ui.parent),
+		ContextFunc: ui.errContextFunc,
+		CauseFunc:   ui.errCauseFunc,
+	}))
+
+	return true
+}
+
+func (ui *ColorUI) TerminalWidth() int {
+	return TerminalWidth(ui.parent)
+}
+
+func (ui *ColorUI) FormatLink(text, url string) string {
+	return FormatLink(ui.parent, text, url)
 }
 
 func (ui *ColorUI) AskForText(opts TextOpts) (string, error) {
@@ -99,5 +170,11 @@ func (ui *ColorUI) colorValueFmt(val Value) Value {
 		}
 		return valFmt
 	}
+	if valStatus, ok := val.(ValueStatus); ok {
+		if valStatus.Func == nil {
+			valStatus.Func = ui.statusTheme[valStatus.Level]
+		}
+		return valStatus
+	}
 	return val
 }
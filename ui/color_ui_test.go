@@ -0,0 +1,110 @@
+package ui_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cppforlife/color"
+
+	. "github.com/cppforlife/go-cli-ui/ui"
+	fakeui "github.com/cppforlife/go-cli-ui/ui/fakes"
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorUI(t *testing.T) {
+	t.Run("PrintTable", func(t *testing.T) {
+		t.Run("colors status values based on theme", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewColorUIWithStatusTheme(parentUI, StatusTheme{
+				StatusWarning: func(pattern string, args ...interface{}) string { return "warn" },
+			})
+
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Status")},
+				Rows: [][]Value{
+					{ValueStatus{Level: StatusWarning, Text: "degraded"}},
+					{ValueStatus{Level: StatusOK, Text: "running"}},
+				},
+			})
+
+			warnFunc := parentUI.Table.Rows[0][0].(ValueStatus).Func
+			assert.Equal(t, warnFunc("%s", "degraded"), "warn")
+			assert.Nil(t, parentUI.Table.Rows[1][0].(ValueStatus).Func)
+		})
+
+		t.Run("keeps custom status format function", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewColorUI(parentUI)
+
+			customFunc := func(pattern string, args ...interface{}) string { return "custom" }
+
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Status")},
+				Rows:   [][]Value{{ValueStatus{Level: StatusWarning, Func: customFunc}}},
+			})
+
+			assert.Equal(t, parentUI.Table.Rows[0][0].(ValueStatus).Func("%s", "degraded"), "custom")
+		})
+	})
+
+	t.Run("PrintErrorBlock", func(t *testing.T) {
+		t.Run("colors whole block without restyling it", func(t *testing.T) {
+			prevNoColor := color.NoColor
+			color.NoColor = false
+			defer func() { color.NoColor = prevNoColor }()
+
+			parentUI := &fakeui.FakeUI{}
+			ui := NewColorUI(parentUI)
+
+			ui.PrintErrorBlock("{\n  \"kind\": \"Status\"\n}")
+
+			assert.Equal(t, parentUI.Blocks, []string{"\x1b[31m{\n  \"kind\": \"Status\"\n}\x1b[0m"})
+		})
+	})
+
+	t.Run("PrintError", func(t *testing.T) {
+		t.Run("wraps error to terminal width of parent UI", func(t *testing.T) {
+			prevNoColor := color.NoColor
+			color.NoColor = true
+			defer func() { color.NoColor = prevNoColor }()
+
+			parentUI := &fakeui.FakeUI{Width: 30}
+			ui := NewColorUI(parentUI)
+
+			PrintError(ui, fmt.Errorf("Applying: %w", fmt.Errorf("Service in version \"v1\" cannot be handled as a Service")))
+
+			assert.Equal(t, parentUI.Errors, []string{
+				"Error: Applying:\n  Service in version \"v1\"\n    cannot be handled as a\n    Service",
+			})
+		})
+
+		t.Run("styles context and innermost cause differently", func(t *testing.T) {
+			prevNoColor := color.NoColor
+			color.NoColor = false
+			defer func() { color.NoColor = prevNoColor }()
+
+			parentUI := &fakeui.FakeUI{}
+			ui := NewColorUI(parentUI)
+
+			PrintError(ui, fmt.Errorf("Applying: %w", fmt.Errorf("Invalid value")))
+
+			assert.Equal(t, parentUI.Errors, []string{
+				"\x1b[2mError: Applying:\x1b[0m\n  \x1b[31;1mInvalid value\x1b[0m",
+			})
+		})
+
+		t.Run("styles errors through wrapping UIs", func(t *testing.T) {
+			prevNoColor := color.NoColor
+			color.NoColor = false
+			defer func() { color.NoColor = prevNoColor }()
+
+			parentUI := &fakeui.FakeUI{}
+			ui := NewPaddingUI(NewColorUI(parentUI))
+
+			PrintError(ui, fmt.Errorf("Invalid value"))
+
+			assert.Equal(t, parentUI.Errors, []string{"\x1b[31;1mError: Invalid value\x1b[0m"})
+		})
+	})
+}
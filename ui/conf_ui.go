@@ -9,6 +9,11 @@ type ConfUI struct {
 	isTTY       bool
 	logger      ExternalLogger
 	showColumns []Header
+	showWide    bool
+
+	selectColumns []string
+
+	customColumns []CustomColumn
 }
 
 func NewConfUI(logger ExternalLogger) *ConfUI {
@@ -46,10 +51,33 @@ func (ui *ConfUI) EnableJSON() {
 	ui.parent = NewJSONUI(ui.parent, ui.logger)
 }
 
+// EnableTypedJSON is like EnableJSON but table cells are output
+// as native JSON values instead of strings
+func (ui *ConfUI) EnableTypedJSON() {
+	ui.parent = NewTypedJSONUI(ui.parent, ui.logger)
+}
+
+// ShowColumns shows specified columns in specified order (see Table.ShowColumns)
 func (ui *ConfUI) ShowColumns(columns []Header) {
 	ui.showColumns = columns
 }
 
+// SelectColumns shows columns matching selectors (see Table.SelectColumns)
+func (ui *ConfUI) SelectColumns(selectors []string) {
+	ui.selectColumns = selectors
+}
+
+// ShowCustomColumns configures tables to show only specified columns
+// in specified order with optionally overridden titles
+func (ui *ConfUI) ShowCustomColumns(columns []CustomColumn) {
+	ui.customColumns = columns
+}
+
+// EnableWideColumns shows columns marked as wide (e.g. -o wide)
+func (ui *ConfUI) EnableWideColumns() {
+	ui.showWide = true
+}
+
 func (ui *ConfUI) EnableNonInteractive() {
 	ui.parent = NewNonInteractiveUI(ui.parent)
 }
@@ -79,16 +107,61 @@ func (ui *ConfUI) PrintErrorBlock(block string) {
 }
 
 func (ui *ConfUI) PrintTable(table Table) {
-	if len(ui.showColumns) > 0 {
-		err := table.SetColumnVisibility(ui.showColumns)
-		if err != nil {
-			panic(err)
-		}
+	err := ui.configureTable(&table)
+	if err != nil {
+		ui.parent.ErrorLinef("%s", err)
+		return
 	}
 
 	ui.parent.PrintTable(table)
 }
 
+func (ui *ConfUI) StartLiveTable(table Table) LiveTable {
+	mapFunc := func(table Table) (Table, error) {
+		err := ui.configureTable(&table)
+		return table, err
+	}
+	errFunc := func(err error) { ui.parent.ErrorLinef("%s", err) }
+
+	return startMappingLiveTable(ui.parent, table, mapFunc, errFunc)
+}
+
+// PrintTableStream reorders streamed rows the same way
+// as configured columns of a table (see SelectColumns)
+func (ui *ConfUI) PrintTableStream(table Table, rows RowIterator, opts StreamOpts) {
+	perm, err := ui.configureTableStream(&table)
+	if err != nil {
+		ui.parent.ErrorLinef("%s", err)
+		return
+	}
+
+	mapFunc := func(row []Value) []Value {
+		newRow := make([]Value, len(perm))
+		for i, idx := range perm {
+			if idx < len(row) {
+				newRow[i] = row[idx]
+			} else {
+				newRow[i] = ValueNone{}
+			}
+		}
+		return newRow
+	}
+
+	PrintTableStream(ui.parent, table, mapRows(rows, mapFunc), opts)
+}
+
+func (ui *ConfUI) PrintError(err error) bool {
+	return forwardError(ui.parent, err)
+}
+
+func (ui *ConfUI) TerminalWidth() int {
+	return TerminalWidth(ui.parent)
+}
+
+func (ui *ConfUI) FormatLink(text, url string) string {
+	return FormatLink(ui.parent, text, url)
+}
+
 func (ui *ConfUI) AskForText(opts TextOpts) (string, error) {
 	return ui.parent.AskForText(opts)
 }
@@ -112,3 +185,58 @@ func (ui *ConfUI) IsInteractive() bool {
 func (ui *ConfUI) Flush() {
 	ui.parent.Flush()
 }
+
+func (ui *ConfUI) configureTable(table *Table) error {
+	if ui.showWide {
+		table.ShowWideColumns = true
+	}
+
+	if len(ui.showColumns) > 0 {
+		err := table.ShowColumns(ui.showColumns)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(ui.selectColumns) > 0 {
+		err := table.SelectColumns(ui.selectColumns)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(ui.customColumns) > 0 {
+		err := table.ApplyCustomColumns(ui.customColumns)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// configureTableStream returns original column index for each configured column
+// (determined by configuring a table with a row of column indexes)
+func (ui *ConfUI) configureTableStream(table *Table) ([]int, error) {
+	idxRow := make([]Value, len(table.Header))
+	for i := range idxRow {
+		idxRow[i] = ValueInt{I: i}
+	}
+
+	table.Rows = [][]Value{idxRow}
+	table.Sections = nil
+
+	err := ui.configureTable(table)
+	if err != nil {
+		return nil, err
+	}
+
+	var perm []int
+	for _, val := range table.Rows[0] {
+		perm = append(perm, val.(ValueInt).I)
+	}
+
+	table.Rows = nil
+
+	return perm, nil
+}
@@ -0,0 +1,119 @@
+package ui_test
+
+import (
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui"
+	fakeui "github.com/cppforlife/go-cli-ui/ui/fakes"
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfUI(t *testing.T) {
+	t.Run("PrintTable", func(t *testing.T) {
+		t.Run("shows columns in requested order", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewWrappingConfUI(parentUI, NewRecordingLogger())
+
+			ui.ShowColumns([]Header{NewHeader("Version"), NewHeader("Name")})
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Name"), NewHeader("Version")},
+				Rows: [][]Value{
+					{ValueString{S: "name1"}, ValueString{S: "ver1"}},
+				},
+			})
+
+			assert.Equal(t, parentUI.Table.Header, []Header{NewHeader("Version"), NewHeader("Name")})
+			assert.Equal(t, parentUI.Table.Rows, [][]Value{
+				{ValueString{S: "ver1"}, ValueString{S: "name1"}},
+			})
+		})
+
+		t.Run("shows columns matching headers literally", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewWrappingConfUI(parentUI, NewRecordingLogger())
+
+			ui.ShowColumns([]Header{{Title: "-Used *"}})
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Name"), {Key: "used", Title: "-Used *"}},
+				Rows: [][]Value{
+					{ValueString{S: "name1"}, ValueString{S: "used1"}},
+				},
+			})
+
+			assert.Equal(t, parentUI.Errors, []string(nil))
+			assert.Equal(t, parentUI.Table.Header, []Header{
+				{Key: "used", Title: "-Used *"},
+				{Key: "name", Title: "Name", Hidden: true},
+			})
+		})
+
+		t.Run("shows wide columns when enabled", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewWrappingConfUI(parentUI, NewRecordingLogger())
+
+			ui.EnableWideColumns()
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Name"), NewHeader("Version")},
+				Rows: [][]Value{
+					{ValueString{S: "name1"}, ValueString{S: "ver1"}},
+				},
+			})
+
+			assert.Equal(t, parentUI.Table.ShowWideColumns, true)
+		})
+
+		t.Run("reports unknown selected columns", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewWrappingConfUI(parentUI, NewRecordingLogger())
+
+			ui.SelectColumns([]string{"-unknown"})
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Name"), NewHeader("Version")},
+				Rows: [][]Value{
+					{ValueString{S: "name1"}, ValueString{S: "ver1"}},
+				},
+			})
+
+			assert.Equal(t, len(parentUI.Tables), 0)
+			assert.Equal(t, parentUI.Errors, []string{"Unknown column 'unknown' (valid columns: name, version)"})
+		})
+
+		t.Run("applies custom columns", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewWrappingConfUI(parentUI, NewRecordingLogger())
+
+			ui.ShowCustomColumns([]CustomColumn{{Title: "VER", Key: "version"}})
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Name"), NewHeader("Version")},
+				Rows: [][]Value{
+					{ValueString{S: "name1"}, ValueString{S: "ver1"}},
+				},
+			})
+
+			assert.Equal(t, parentUI.Table.Header, []Header{
+				{Key: "version", Title: "VER"},
+				{Key: "name", Title: "Name", Hidden: true},
+			})
+			assert.Equal(t, parentUI.Table.Rows, [][]Value{
+				{ValueString{S: "ver1"}, ValueString{S: "name1"}},
+			})
+		})
+
+		t.Run("reports error instead of printing table when column is unknown", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewWrappingConfUI(parentUI, NewRecordingLogger())
+
+			ui.ShowCustomColumns([]CustomColumn{{Key: "unknown"}})
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Name"), NewHeader("Version")},
+				Rows: [][]Value{
+					{ValueString{S: "name1"}, ValueString{S: "ver1"}},
+				},
+			})
+
+			assert.Equal(t, len(parentUI.Tables), 0)
+			assert.Equal(t, parentUI.Errors, []string{"Failed to find header: unknown"})
+		})
+	})
+}
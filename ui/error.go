@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"fmt"
+
+	uierrors "github.com/cppforlife/go-cli-ui/errors"
+)
+
+// PrintError prints command error as a multi-line error
+// unless UI prints errors in a specific way (e.g. JSON UI)
+func PrintError(ui UI, err error) {
+	if printer, ok := ui.(ErrorPrinter); ok && printer.PrintError(err) {
+		return
+	}
+	ui.ErrorLinef("%s", errorMessage(err))
+}
+
+func errorMessage(err error) string {
+	return fmt.Sprintf("Error: %s", uierrors.NewMultiLineError(err))
+}
+
+// forwardError is used by wrapping UIs so that errors printed
+// as lines go through wrapping UIs (e.g. to be colored)
+func forwardError(parent UI, err error) bool {
+	printer, ok := parent.(ErrorPrinter)
+	return ok && printer.PrintError(err)
+}
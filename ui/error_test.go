@@ -0,0 +1,34 @@
+package ui_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui"
+	fakeui "github.com/cppforlife/go-cli-ui/ui/fakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintError(t *testing.T) {
+	t.Run("prints error as multi-line error line", func(t *testing.T) {
+		parentUI := &fakeui.FakeUI{}
+		ui := NewPaddingUI(NewNonInteractiveUI(parentUI))
+
+		PrintError(ui, fmt.Errorf("Deploying: %w", fmt.Errorf("Invalid value")))
+
+		assert.Equal(t, parentUI.Errors, []string{"Error: Deploying:\n  Invalid value"})
+	})
+
+	t.Run("forwards to JSON UI through wrapping UIs", func(t *testing.T) {
+		parentUI := &fakeui.FakeUI{}
+		jsonUI := NewJSONUI(parentUI, NewRecordingLogger())
+		ui := NewPaddingUI(NewNonTTYUI(NewColorUI(jsonUI)))
+
+		PrintError(ui, fmt.Errorf("Invalid value"))
+		ui.Flush()
+
+		assert.Equal(t, parentUI.Errors, []string(nil))
+		assert.Equal(t, len(parentUI.Blocks), 1)
+		assert.Contains(t, parentUI.Blocks[0], `"Message": "Invalid value"`)
+	})
+}
@@ -17,6 +17,10 @@ type FakeUI struct {
 	Table  Table
 	Tables []Table
 
+	// LiveTables includes initial table and each update
+	LiveTables       []Table
+	LiveTableStopped bool
+
 	AskedTextLabels []string
 	AskedText       []Answer
 
@@ -34,6 +38,9 @@ type FakeUI struct {
 
 	Interactive bool
 
+	// Width is returned as terminal width
+	Width int
+
 	Flushed bool
 
 	mutex sync.Mutex
@@ -94,6 +101,31 @@ func (ui *FakeUI) PrintTable(table Table) {
 	ui.Tables = append(ui.Tables, table)
 }
 
+func (ui *FakeUI) StartLiveTable(table Table) types.LiveTable {
+	liveTable := fakeLiveTable{ui}
+	liveTable.Update(table)
+	return liveTable
+}
+
+type fakeLiveTable struct {
+	ui *FakeUI
+}
+
+func (t fakeLiveTable) Update(table Table) {
+	t.ui.mutex.Lock()
+	defer t.ui.mutex.Unlock()
+
+	t.ui.Table = table
+	t.ui.LiveTables = append(t.ui.LiveTables, table)
+}
+
+func (t fakeLiveTable) Stop() {
+	t.ui.mutex.Lock()
+	defer t.ui.mutex.Unlock()
+
+	t.ui.LiveTableStopped = true
+}
+
 func (ui *FakeUI) AskForText(opts types.TextOpts) (string, error) {
 	ui.mutex.Lock()
 	defer ui.mutex.Unlock()
@@ -147,6 +179,13 @@ func (ui *FakeUI) IsInteractive() bool {
 	return ui.Interactive
 }
 
+func (ui *FakeUI) TerminalWidth() int {
+	ui.mutex.Lock()
+	defer ui.mutex.Unlock()
+
+	return ui.Width
+}
+
 func (ui *FakeUI) Flush() {
 	ui.mutex.Lock()
 	defer ui.mutex.Unlock()
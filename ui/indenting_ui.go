@@ -42,6 +42,26 @@ func (ui *IndentingUI) PrintTable(table Table) {
 	ui.parent.PrintTable(table)
 }
 
+func (ui *IndentingUI) StartLiveTable(table Table) LiveTable {
+	return StartLiveTable(ui.parent, table)
+}
+
+func (ui *IndentingUI) PrintTableStream(table Table, rows RowIterator, opts StreamOpts) {
+	PrintTableStream(ui.parent, table, rows, opts)
+}
+
+func (ui *IndentingUI) PrintError(err error) bool {
+	return forwardError(ui.parent, err)
+}
+
+func (ui *IndentingUI) TerminalWidth() int {
+	return TerminalWidth(ui.parent)
+}
+
+func (ui *IndentingUI) FormatLink(text, url string) string {
+	return FormatLink(ui.parent, text, url)
+}
+
 func (ui *IndentingUI) AskForText(opts TextOpts) (string, error) {
 	return ui.parent.AskForText(opts)
 }
@@ -28,6 +28,45 @@ type UI interface {
 	Flush()
 }
 
+// LinkFormatter is optionally implemented by UIs that can render
+// links depending on output capabilities (see FormatLink)
+type LinkFormatter interface {
+	FormatLink(text, url string) string
+}
+
+// LiveTable is a printed table that can be updated (e.g. in watch mode)
+type LiveTable interface {
+	Update(Table)
+	// Stop finishes updates leaving last version of a table printed
+	Stop()
+}
+
+// LiveTableUI is optionally implemented by UIs that can update
+// printed tables (see StartLiveTable)
+type LiveTableUI interface {
+	StartLiveTable(Table) LiveTable
+}
+
+// TableStreamUI is optionally implemented by UIs that can print
+// table rows as they are received (see PrintTableStream)
+type TableStreamUI interface {
+	PrintTableStream(Table, RowIterator, StreamOpts)
+}
+
+// ErrorPrinter is optionally implemented by UIs that print
+// errors in a specific way (see PrintError); it returns
+// false if error should be printed as a line instead
+type ErrorPrinter interface {
+	PrintError(err error) bool
+}
+
+// TerminalWidthUI is optionally implemented by UIs that know
+// width of a terminal they print to (see TerminalWidth)
+type TerminalWidthUI interface {
+	// TerminalWidth returns 0 if width is not known
+	TerminalWidth() int
+}
+
 type ExternalLogger interface {
 	Error(tag, msg string, args ...interface{})
 	Debug(tag, msg string, args ...interface{})
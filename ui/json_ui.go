@@ -1,11 +1,13 @@
 package ui
 
 import (
+	_ "embed"
 	"encoding/json"
 	"fmt"
 	"reflect"
 	"strconv"
 
+	uierrors "github.com/cppforlife/go-cli-ui/errors"
 	. "github.com/cppforlife/go-cli-ui/ui/table"
 )
 
@@ -13,27 +15,108 @@ type JSONUI struct {
 	parent UI
 	uiResp JSONUIResp
 
+	typed bool
+
 	logTag string
 	logger ExternalLogger
 }
 
+// JSONUISchemaVersion is incremented when JSON UI output changes incompatibly
+const JSONUISchemaVersion = "1"
+
+// JSONUISchema is a JSON Schema describing JSON UI output
+//
+//go:embed json_ui_schema.json
+var JSONUISchema []byte
+
 type JSONUIResp struct {
+	SchemaVersion string `json:",omitempty"`
+
 	Tables []JSONUITableResp
 	Blocks []string
 	Lines  []string
+
+	// Errors printed via PrintError
+	Errors []uierrors.StructuredError `json:",omitempty"`
 }
 
 type JSONUITableResp struct {
 	Content string
+	Title   string              `json:",omitempty"`
+	Columns []JSONUITableColumn `json:",omitempty"`
 	Header  map[string]string
 	Rows    []map[string]string
+	Footer  []map[string]string `json:",omitempty"`
+	SortBy  []JSONUITableSort   `json:",omitempty"`
 	Notes   []string
+
+	// Levels of status values (see table.ValueStatus) for each row
+	Levels []map[string]StatusLevel `json:",omitempty"`
+	// URLs of links (see table.ValueLink) for each row
+	Links []map[string]string `json:",omitempty"`
+
+	typedRows   []map[string]interface{}
+	typedFooter []map[string]interface{}
+}
+
+// jsonUITypedTableResp mirrors JSONUITableResp with natively typed cells
+type jsonUITypedTableResp struct {
+	Content string
+	Title   string              `json:",omitempty"`
+	Columns []JSONUITableColumn `json:",omitempty"`
+	Header  map[string]string
+	Rows    []map[string]interface{}
+	Footer  []map[string]interface{} `json:",omitempty"`
+	SortBy  []JSONUITableSort        `json:",omitempty"`
+	Notes   []string
+	Levels  []map[string]StatusLevel `json:",omitempty"`
+	Links   []map[string]string      `json:",omitempty"`
+}
+
+// JSONUITableColumn describes table column in the order it's shown
+type JSONUITableColumn struct {
+	Key    string
+	Title  string
+	Type   string `json:",omitempty"`
+	Hidden bool   `json:",omitempty"`
+	Wide   bool   `json:",omitempty"`
+}
+
+type JSONUITableSort struct {
+	Key string
+	Asc bool
 }
 
 func NewJSONUI(parent UI, logger ExternalLogger) *JSONUI {
 	return &JSONUI{parent: parent, logTag: "JSONUI", logger: logger}
 }
 
+// NewTypedJSONUI returns JSON UI that outputs table cells
+// as native JSON values (numbers, booleans, etc.) instead of strings
+func NewTypedJSONUI(parent UI, logger ExternalLogger) *JSONUI {
+	return &JSONUI{parent: parent, typed: true, logTag: "JSONUI", logger: logger}
+}
+
+func (r JSONUITableResp) MarshalJSON() ([]byte, error) {
+	if r.typedRows == nil {
+		type plainTableResp JSONUITableResp
+		return json.Marshal(plainTableResp(r))
+	}
+
+	return json.Marshal(jsonUITypedTableResp{
+		Content: r.Content,
+		Title:   r.Title,
+		Columns: r.Columns,
+		Header:  r.Header,
+		Rows:    r.typedRows,
+		Footer:  r.typedFooter,
+		SortBy:  r.SortBy,
+		Notes:   r.Notes,
+		Levels:  r.Levels,
+		Links:   r.Links,
+	})
+}
+
 func (ui *JSONUI) ErrorLinef(pattern string, args ...interface{}) {
 	ui.addLine(pattern, args)
 }
@@ -58,21 +141,47 @@ func (ui *JSONUI) PrintErrorBlock(block string) {
 	ui.uiResp.Blocks = append(ui.uiResp.Blocks, block)
 }
 
+// PrintError includes error structure (e.g. context, listed errors)
+func (ui *JSONUI) PrintError(err error) bool {
+	ui.uiResp.Errors = append(ui.uiResp.Errors, uierrors.NewStructuredError(err))
+	return true
+}
+
 func (ui *JSONUI) PrintTable(table Table) {
+	ui.uiResp.Tables = append(ui.uiResp.Tables, ui.tableResp(table))
+}
+
+// StartLiveTable prints each update right away as a single line JSON document
+// that only includes updated table. Output of commands with live tables
+// is therefore a stream of JSON documents (e.g. to be read via json.Decoder):
+// one per update followed by a document printed when UI is flushed.
+func (ui *JSONUI) StartLiveTable(table Table) LiveTable {
+	liveTable := jsonLiveTable{ui}
+	liveTable.Update(table)
+	return liveTable
+}
+
+// PrintTableStream includes all received rows in a single table
+// since JSON document is printed only once UI is flushed
+func (ui *JSONUI) PrintTableStream(table Table, rows RowIterator, _ StreamOpts) {
+	ui.PrintTable(collectTableRows(table, rows))
+}
+
+func (ui *JSONUI) tableResp(table Table) JSONUITableResp {
 	table.FillFirstColumn = true
 
 	header := map[string]string{}
 
 	if len(table.Header) > 0 {
 		for i, val := range table.Header {
-			if val.Hidden {
-				continue
-			}
-
 			if val.Key == string(UNKNOWN_HEADER_MAPPING) {
 				table.Header[i].Key = strconv.Itoa(i)
 			}
 
+			if val.Hidden {
+				continue
+			}
+
 			header[table.Header[i].Key] = val.Title
 		}
 	} else if len(table.AsRows()) > 0 {
@@ -88,14 +197,32 @@ func (ui *JSONUI) PrintTable(table Table) {
 		table.Header = rawHeaders
 	}
 
+	rows := table.AsRows()
+
+	footerRows, err := table.FooterRows()
+	if err != nil {
+		ui.logger.Error(ui.logTag, "Aggregating footer rows: %s", err)
+	}
+
 	resp := JSONUITableResp{
 		Content: table.Content,
+		Title:   table.Title,
+		Columns: ui.columns(table.Header, rows),
 		Header:  header,
-		Rows:    ui.stringRows(table.Header, table.AsRows()),
+		Rows:    ui.stringRows(table.Header, rows),
+		Footer:  ui.stringRows(table.Header, footerRows),
+		SortBy:  ui.sortBy(table.Header, table.SortBy),
 		Notes:   table.Notes,
+		Levels:  ui.levels(table.Header, rows),
+		Links:   ui.links(table.Header, rows),
 	}
 
-	ui.uiResp.Tables = append(ui.uiResp.Tables, resp)
+	if ui.typed {
+		resp.typedRows = ui.typedRows(table.Header, rows)
+		resp.typedFooter = ui.typedRows(table.Header, footerRows)
+	}
+
+	return resp
 }
 
 func (ui *JSONUI) AskForText(_ TextOpts) (string, error) {
@@ -122,7 +249,10 @@ func (ui *JSONUI) Flush() {
 	defer ui.parent.Flush()
 
 	if !reflect.DeepEqual(ui.uiResp, JSONUIResp{}) {
-		bytes, err := json.MarshalIndent(ui.uiResp, "", "    ")
+		resp := ui.uiResp
+		resp.SchemaVersion = JSONUISchemaVersion
+
+		bytes, err := json.MarshalIndent(resp, "", "    ")
 		if err != nil {
 			ui.logger.Error(ui.logTag, "Failed to marshal UI response")
 			return
@@ -132,6 +262,141 @@ func (ui *JSONUI) Flush() {
 	}
 }
 
+func (ui *JSONUI) columns(header []Header, rows [][]Value) []JSONUITableColumn {
+	var result []JSONUITableColumn
+
+	for i, h := range header {
+		result = append(result, JSONUITableColumn{
+			Key:    h.Key,
+			Title:  h.Title,
+			Type:   ui.columnType(i, rows),
+			Hidden: h.Hidden,
+			Wide:   h.Wide,
+		})
+	}
+
+	return result
+}
+
+// columnType returns type shared by all non-empty values in a column
+func (ui *JSONUI) columnType(colIdx int, rows [][]Value) string {
+	var result string
+
+	for _, row := range rows {
+		if colIdx >= len(row) || row[colIdx] == nil {
+			continue
+		}
+
+		var typ string
+
+		switch row[colIdx].Value().(type) {
+		case ValueNone, EmptyValue:
+			continue
+		case ValueString:
+			typ = "string"
+		case ValueStrings:
+			typ = "strings"
+		case ValueInt, ValueInt64, ValueUint64:
+			typ = "int"
+		case ValueFloat:
+			typ = "float"
+		case ValuePercent:
+			typ = "percent"
+		case ValueBytes:
+			typ = "bytes"
+		case ValueDuration:
+			typ = "duration"
+		case ValueStatus:
+			typ = "status"
+		case ValueLink:
+			typ = "link"
+		case ValueBool:
+			typ = "bool"
+		case ValueTime, ValueRelativeTime:
+			typ = "time"
+		case ValueError:
+			typ = "error"
+		case ValueInterface:
+			typ = "interface"
+		default:
+			return ""
+		}
+
+		if len(result) > 0 && result != typ {
+			return ""
+		}
+		result = typ
+	}
+
+	return result
+}
+
+// levels returns nil if there are no status values
+func (ui *JSONUI) levels(header []Header, rows [][]Value) []map[string]StatusLevel {
+	var result []map[string]StatusLevel
+	var found bool
+
+	for _, row := range rows {
+		data := map[string]StatusLevel{}
+
+		for i, col := range row {
+			if header[i].Hidden || col == nil {
+				continue
+			}
+			if status, ok := col.Value().(ValueStatus); ok {
+				data[header[i].Key] = status.Level
+				found = true
+			}
+		}
+
+		result = append(result, data)
+	}
+
+	if !found {
+		return nil
+	}
+	return result
+}
+
+// links returns nil if there are no link values
+func (ui *JSONUI) links(header []Header, rows [][]Value) []map[string]string {
+	var result []map[string]string
+	var found bool
+
+	for _, row := range rows {
+		data := map[string]string{}
+
+		for i, col := range row {
+			if header[i].Hidden || col == nil {
+				continue
+			}
+			if link, ok := col.Value().(ValueLink); ok {
+				data[header[i].Key] = link.URL
+				found = true
+			}
+		}
+
+		result = append(result, data)
+	}
+
+	if !found {
+		return nil
+	}
+	return result
+}
+
+func (ui *JSONUI) sortBy(header []Header, sortBy []ColumnSort) []JSONUITableSort {
+	var result []JSONUITableSort
+
+	for _, sort := range sortBy {
+		if sort.Column < len(header) {
+			result = append(result, JSONUITableSort{Key: header[sort.Column].Key, Asc: sort.Asc})
+		}
+	}
+
+	return result
+}
+
 func (ui *JSONUI) stringRows(header []Header, rows [][]Value) []map[string]string {
 	result := []map[string]string{}
 
@@ -143,7 +408,50 @@ func (ui *JSONUI) stringRows(header []Header, rows [][]Value) []map[string]strin
 				continue
 			}
 
-			data[header[i].Key] = col.String()
+			switch typedCol := col.Value().(type) {
+			case ValueLink:
+				// URLs are included separately (see links)
+				data[header[i].Key] = typedCol.Text
+			case ValueBytes, ValueDuration, ValuePercent, ValueRelativeTime:
+				// Human readable representations (e.g. 1.2 GiB, 5m ago) are
+				// rounded or change over time so raw values are included instead
+				data[header[i].Key] = rawStringValue(col)
+			default:
+				data[header[i].Key] = col.String()
+			}
+		}
+
+		result = append(result, data)
+	}
+
+	return result
+}
+
+// rawStringValue returns value as it's included in typed output
+// (e.g. number of bytes, duration in nanoseconds)
+func rawStringValue(val Value) string {
+	switch typedVal := JSONValueOf(val).(type) {
+	case nil:
+		return ""
+	case string:
+		return typedVal
+	default:
+		return fmt.Sprintf("%v", typedVal)
+	}
+}
+
+func (ui *JSONUI) typedRows(header []Header, rows [][]Value) []map[string]interface{} {
+	result := []map[string]interface{}{}
+
+	for _, row := range rows {
+		data := map[string]interface{}{}
+
+		for i, col := range row {
+			if header[i].Hidden {
+				continue
+			}
+
+			data[header[i].Key] = JSONValueOf(col)
 		}
 
 		result = append(result, data)
@@ -152,6 +460,27 @@ func (ui *JSONUI) stringRows(header []Header, rows [][]Value) []map[string]strin
 	return result
 }
 
+type jsonLiveTable struct {
+	ui *JSONUI
+}
+
+func (t jsonLiveTable) Update(table Table) {
+	resp := JSONUIResp{
+		SchemaVersion: JSONUISchemaVersion,
+		Tables:        []JSONUITableResp{t.ui.tableResp(table)},
+	}
+
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		t.ui.logger.Error(t.ui.logTag, "Failed to marshal live table snapshot")
+		return
+	}
+
+	t.ui.parent.PrintBlock(append(bytes, '\n'))
+}
+
+func (t jsonLiveTable) Stop() {}
+
 func (ui *JSONUI) addLine(pattern string, args []interface{}) {
 	msg := fmt.Sprintf(pattern, args...)
 	ui.uiResp.Lines = append(ui.uiResp.Lines, msg)
@@ -2,8 +2,11 @@ package ui_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
+	uierrors "github.com/cppforlife/go-cli-ui/errors"
 	. "github.com/cppforlife/go-cli-ui/ui"
 	fakeui "github.com/cppforlife/go-cli-ui/ui/fakes"
 	. "github.com/cppforlife/go-cli-ui/ui/table"
@@ -15,6 +18,7 @@ func TestJSONUI(t *testing.T) {
 		Content string
 		Header  map[string]string
 		Rows    []map[string]string
+		Footer  []map[string]string
 		Notes   []string
 	}
 
@@ -22,6 +26,7 @@ func TestJSONUI(t *testing.T) {
 		Tables []tableResp
 		Blocks []string
 		Lines  []string
+		Errors []uierrors.StructuredError
 	}
 
 	finalOutput := func(ui UI, parentUI *fakeui.FakeUI) uiResp {
@@ -115,6 +120,23 @@ func TestJSONUI(t *testing.T) {
 		})
 	})
 
+	t.Run("PrintError", func(t *testing.T) {
+		t.Run("includes structured error in Errors", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewJSONUI(parentUI, NewRecordingLogger())
+
+			PrintError(ui, fmt.Errorf("Deploying: %w", fmt.Errorf("spec.replicas: Invalid value")))
+			assert.Equal(t, finalOutput(ui, parentUI), uiResp{
+				Errors: []uierrors.StructuredError{{
+					Message: "Deploying: spec.replicas: Invalid value",
+					Context: []string{"Deploying"},
+					Detail:  "spec.replicas: Invalid value",
+				}},
+			})
+			assert.Equal(t, parentUI.Errors, []string(nil))
+		})
+	})
+
 	t.Run("PrintTable", func(t *testing.T) {
 		t.Run("includes table response in Tables", func(t *testing.T) {
 			parentUI := &fakeui.FakeUI{}
@@ -268,6 +290,334 @@ func TestJSONUI(t *testing.T) {
 			assert.Equal(t, tableOutput.Tables[0].Rows[1], map[string]string{"0": "r2c1", "foo": "r2c2", "2": "r2c3"})
 		})
 
+		t.Run("includes wide columns", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewJSONUI(parentUI, NewRecordingLogger())
+
+			wideHeader := NewHeader("CID")
+			wideHeader.Wide = true
+
+			table := Table{
+				Content: "things",
+				Header:  []Header{NewHeader("Header1"), wideHeader},
+				Rows: [][]Value{
+					{ValueString{S: "r1c1"}, ValueString{S: "cid1"}},
+				},
+			}
+
+			ui.PrintTable(table)
+
+			assert.Equal(t, finalOutput(ui, parentUI), uiResp{
+				Tables: []tableResp{
+					{
+						Content: "things",
+						Header:  map[string]string{"header1": "Header1", "cid": "CID"},
+						Rows:    []map[string]string{{"header1": "r1c1", "cid": "cid1"}},
+					},
+				},
+			})
+		})
+
+		t.Run("includes footer separately from rows", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewJSONUI(parentUI, NewRecordingLogger())
+
+			table := Table{
+				Content: "things",
+				Header:  []Header{NewHeader("Name"), NewHeader("Count")},
+				Rows: [][]Value{
+					{ValueString{S: "a"}, ValueInt{I: 1}},
+					{ValueString{S: "b"}, ValueInt{I: 2}},
+				},
+				Footer: []FooterRow{{
+					Aggregates: []ColumnAggregate{{Column: 1, Func: AggregateSum}},
+				}},
+			}
+
+			ui.PrintTable(table)
+
+			assert.Equal(t, finalOutput(ui, parentUI), uiResp{
+				Tables: []tableResp{
+					{
+						Content: "things",
+						Header:  map[string]string{"name": "Name", "count": "Count"},
+						Rows:    []map[string]string{{"name": "a", "count": "1"}, {"name": "b", "count": "2"}},
+						Footer:  []map[string]string{{"name": "", "count": "3"}},
+					},
+				},
+			})
+		})
+
+		t.Run("outputs natively typed cells when typed", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewTypedJSONUI(parentUI, NewRecordingLogger())
+
+			table := Table{
+				Content: "things",
+				Header: []Header{
+					NewHeader("Name"), NewHeader("Count"), NewHeader("Ready"),
+					NewHeader("Created"), NewHeader("Tags"), NewHeader("Meta"), NewHeader("Other"),
+				},
+				Rows: [][]Value{{
+					ValueString{S: "a"},
+					ValueFmt{V: ValueInt{I: 3}},
+					ValueBool{B: true},
+					ValueTime{T: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)},
+					ValueStrings{S: []string{"t1", "t2"}},
+					ValueInterface{I: map[string]interface{}{"k": []int{1}}},
+					ValueNone{},
+				}},
+				Footer: []FooterRow{{
+					Aggregates: []ColumnAggregate{{Column: 1, Func: AggregateSum}},
+				}},
+			}
+
+			ui.PrintTable(table)
+			ui.Flush()
+
+			assert.Equal(t, parentUI.Blocks[0], `{
+    "SchemaVersion": "1",
+    "Tables": [
+        {
+            "Content": "things",
+            "Columns": [
+                {
+                    "Key": "name",
+                    "Title": "Name",
+                    "Type": "string"
+                },
+                {
+                    "Key": "count",
+                    "Title": "Count",
+                    "Type": "int"
+                },
+                {
+                    "Key": "ready",
+                    "Title": "Ready",
+                    "Type": "bool"
+                },
+                {
+                    "Key": "created",
+                    "Title": "Created",
+                    "Type": "time"
+                },
+                {
+                    "Key": "tags",
+                    "Title": "Tags",
+                    "Type": "strings"
+                },
+                {
+                    "Key": "meta",
+                    "Title": "Meta",
+                    "Type": "interface"
+                },
+                {
+                    "Key": "other",
+                    "Title": "Other"
+                }
+            ],
+            "Header": {
+                "count": "Count",
+                "created": "Created",
+                "meta": "Meta",
+                "name": "Name",
+                "other": "Other",
+                "ready": "Ready",
+                "tags": "Tags"
+            },
+            "Rows": [
+                {
+                    "count": 3,
+                    "created": "2020-01-02T03:04:05Z",
+                    "meta": {
+                        "k": [
+                            1
+                        ]
+                    },
+                    "name": "a",
+                    "other": null,
+                    "ready": true,
+                    "tags": [
+                        "t1",
+                        "t2"
+                    ]
+                }
+            ],
+            "Footer": [
+                {
+                    "count": 3,
+                    "created": null,
+                    "meta": null,
+                    "name": null,
+                    "other": null,
+                    "ready": null,
+                    "tags": null
+                }
+            ],
+            "Notes": null
+        }
+    ],
+    "Blocks": null,
+    "Lines": null
+}`)
+		})
+
+		t.Run("includes ordered columns, title and sort order", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewJSONUI(parentUI, NewRecordingLogger())
+
+			table := Table{
+				Title: "Things",
+				Header: []Header{
+					NewHeader("Name"),
+					{Key: "secret", Title: "Secret", Hidden: true},
+					{Key: "details", Title: "Details", Wide: true},
+				},
+				Rows: [][]Value{
+					{ValueString{S: "b"}, ValueInt{I: 1}, ValueString{S: "d1"}},
+					{ValueString{S: "a"}, ValueNone{}, ValueInt{I: 2}},
+				},
+				SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			}
+
+			ui.PrintTable(table)
+			ui.Flush()
+
+			assert.Equal(t, parentUI.Blocks[0], `{
+    "SchemaVersion": "1",
+    "Tables": [
+        {
+            "Content": "",
+            "Title": "Things",
+            "Columns": [
+                {
+                    "Key": "name",
+                    "Title": "Name",
+                    "Type": "string"
+                },
+                {
+                    "Key": "secret",
+                    "Title": "Secret",
+                    "Type": "int",
+                    "Hidden": true
+                },
+                {
+                    "Key": "details",
+                    "Title": "Details",
+                    "Wide": true
+                }
+            ],
+            "Header": {
+                "details": "Details",
+                "name": "Name"
+            },
+            "Rows": [
+                {
+                    "details": "2",
+                    "name": "a"
+                },
+                {
+                    "details": "d1",
+                    "name": "b"
+                }
+            ],
+            "SortBy": [
+                {
+                    "Key": "name",
+                    "Asc": true
+                }
+            ],
+            "Notes": null
+        }
+    ],
+    "Blocks": null,
+    "Lines": null
+}`)
+		})
+
+		t.Run("includes levels of status values", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewJSONUI(parentUI, NewRecordingLogger())
+
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Name"), NewHeader("Status")},
+				Rows: [][]Value{
+					{ValueString{S: "a"}, ValueStatus{Level: StatusWarning, Text: "degraded"}},
+					{ValueString{S: "b"}, ValueFmt{V: ValueStatus{Level: StatusOK, Text: "running"}}},
+				},
+			})
+			ui.Flush()
+
+			var resp JSONUIResp
+
+			err := json.Unmarshal([]byte(parentUI.Blocks[0]), &resp)
+			assert.Equal(t, err, nil)
+			assert.Equal(t, resp.Tables[0].Rows, []map[string]string{
+				{"name": "a", "status": "degraded"},
+				{"name": "b", "status": "running"},
+			})
+			assert.Equal(t, resp.Tables[0].Levels, []map[string]StatusLevel{
+				{"status": StatusWarning},
+				{"status": StatusOK},
+			})
+			assert.Equal(t, resp.Tables[0].Columns[1].Type, "status")
+		})
+
+		t.Run("includes link texts in rows and URLs separately", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewJSONUI(parentUI, NewRecordingLogger())
+
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Name"), NewHeader("Docs")},
+				Rows: [][]Value{
+					{ValueString{S: "a"}, ValueLink{Text: "docs", URL: "https://d"}},
+					{ValueString{S: "b"}, ValueNone{}},
+				},
+			})
+			ui.Flush()
+
+			var resp JSONUIResp
+
+			err := json.Unmarshal([]byte(parentUI.Blocks[0]), &resp)
+			assert.Equal(t, err, nil)
+			assert.Equal(t, resp.Tables[0].Rows, []map[string]string{
+				{"name": "a", "docs": "docs"},
+				{"name": "b", "docs": ""},
+			})
+			assert.Equal(t, resp.Tables[0].Links, []map[string]string{{"docs": "https://d"}, {}})
+			assert.Equal(t, resp.Tables[0].Columns[1].Type, "link")
+		})
+
+		t.Run("includes raw values instead of human readable sizes, durations and times", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewJSONUI(parentUI, NewRecordingLogger())
+
+			created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+			ui.PrintTable(Table{
+				Header: []Header{NewHeader("Size"), NewHeader("Age"), NewHeader("Usage"), NewHeader("Created")},
+				Rows: [][]Value{
+					{
+						ValueBytes{B: 1288490189},
+						ValueFmt{V: ValueDuration{D: 90 * time.Second}},
+						ValuePercent{F: 0.125},
+						ValueRelativeTime{T: created},
+					},
+					{ValueBytes{B: 0}, ValueDuration{}, ValuePercent{}, ValueRelativeTime{}},
+				},
+			})
+			ui.Flush()
+
+			var resp JSONUIResp
+
+			err := json.Unmarshal([]byte(parentUI.Blocks[0]), &resp)
+			assert.Equal(t, err, nil)
+			assert.Equal(t, resp.Tables[0].Rows, []map[string]string{
+				{"size": "1288490189", "age": "90000000000", "usage": "0.125", "created": "2020-01-02T03:04:05Z"},
+				{"size": "0", "age": "0", "usage": "0", "created": ""},
+			})
+		})
+
 		t.Run("includes in Tables when table has sections and fills in first column", func(t *testing.T) {
 			parentUI := &fakeui.FakeUI{}
 			ui := NewJSONUI(parentUI, NewRecordingLogger())
@@ -370,6 +720,7 @@ func TestJSONUI(t *testing.T) {
 			ui.PrintLinef("fake-line1")
 			ui.Flush()
 			assert.Equal(t, parentUI.Blocks[0], `{
+    "SchemaVersion": "1",
     "Tables": null,
     "Blocks": null,
     "Lines": [
@@ -0,0 +1,190 @@
+package jsonclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	uierrors "github.com/cppforlife/go-cli-ui/errors"
+	"github.com/cppforlife/go-cli-ui/ui"
+	"github.com/cppforlife/go-cli-ui/ui/table"
+)
+
+// Document is a parsed JSON UI output (see ui.JSONUISchema).
+// Table cells are kept raw since they may be strings or typed values.
+type Document struct {
+	SchemaVersion string
+
+	Tables []Table
+	Blocks []string
+	Lines  []string
+	Errors []uierrors.StructuredError
+}
+
+type Table struct {
+	Content string
+	Title   string
+	Columns []ui.JSONUITableColumn
+	Header  map[string]string
+	Rows    []Row
+	Footer  []Row
+	SortBy  []ui.JSONUITableSort
+	Notes   []string
+	Levels  []map[string]table.StatusLevel
+	Links   []map[string]string
+}
+
+// Row maps column keys to raw JSON values
+type Row map[string]json.RawMessage
+
+// Parse parses JSON UI output. Output without schema version
+// (produced by older versions) is accepted as well.
+func Parse(bytes []byte) (Document, error) {
+	var doc Document
+
+	err := json.Unmarshal(bytes, &doc)
+	if err != nil {
+		return Document{}, fmt.Errorf("Unmarshaling JSON UI output: %s", err)
+	}
+
+	if len(doc.SchemaVersion) > 0 && doc.SchemaVersion != ui.JSONUISchemaVersion {
+		return Document{}, fmt.Errorf("Unsupported JSON UI schema version '%s' (supported: %s)",
+			doc.SchemaVersion, ui.JSONUISchemaVersion)
+	}
+
+	return doc, nil
+}
+
+// AsJSONUIResp converts document into response with string cells
+func (d Document) AsJSONUIResp() ui.JSONUIResp {
+	resp := ui.JSONUIResp{
+		SchemaVersion: d.SchemaVersion,
+		Blocks:        d.Blocks,
+		Lines:         d.Lines,
+		Errors:        d.Errors,
+	}
+
+	for _, t := range d.Tables {
+		resp.Tables = append(resp.Tables, ui.JSONUITableResp{
+			Content: t.Content,
+			Title:   t.Title,
+			Columns: t.Columns,
+			Header:  t.Header,
+			Rows:    stringRows(t.Rows),
+			Footer:  stringRows(t.Footer),
+			SortBy:  t.SortBy,
+			Notes:   t.Notes,
+			Levels:  t.Levels,
+			Links:   t.Links,
+		})
+	}
+
+	return resp
+}
+
+// Keys returns keys of visible columns in the order they are shown.
+// Keys are sorted if output does not include column order.
+func (t Table) Keys() []string {
+	var result []string
+
+	if len(t.Columns) > 0 {
+		for _, col := range t.Columns {
+			if !col.Hidden {
+				result = append(result, col.Key)
+			}
+		}
+		return result
+	}
+
+	for key := range t.Header {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// StringRows returns rows with all values converted to strings
+// the same way they are included in non-typed output (byte sizes,
+// durations, percentages and relative times are raw values, e.g. 1024)
+func (t Table) StringRows() []map[string]string {
+	return stringRows(t.Rows)
+}
+
+// DecodeRows decodes rows into a pointer to a slice of structs
+// with fields tagged via `table:"Title,key=name"` (see table.StructTag)
+func (t Table) DecodeRows(dst interface{}) error {
+	return decodeRows(t.Rows, dst)
+}
+
+// String returns value of a column as a string
+func (r Row) String(key string) string {
+	return stringValue(r[key])
+}
+
+// Decode decodes row into a pointer to a struct (see Table.DecodeRows)
+func (r Row) Decode(dst interface{}) error {
+	return decodeRow(r, dst)
+}
+
+func stringRows(rows []Row) []map[string]string {
+	if rows == nil {
+		return nil
+	}
+
+	result := []map[string]string{}
+
+	for _, row := range rows {
+		data := map[string]string{}
+		for key, val := range row {
+			data[key] = stringValue(val)
+		}
+		result = append(result, data)
+	}
+
+	return result
+}
+
+func stringValue(raw json.RawMessage) string {
+	var val interface{}
+
+	if len(raw) == 0 || json.Unmarshal(raw, &val) != nil {
+		return ""
+	}
+
+	switch typedVal := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return typedVal
+	case map[string]interface{}:
+		// Typed status and link values (see table.ValueStatus, table.ValueLink)
+		if text, ok := typedVal["Text"].(string); ok && len(typedVal) == 2 &&
+			(typedVal["Level"] != nil || typedVal["URL"] != nil) {
+			return text
+		}
+		return compactValue(raw)
+	case []interface{}:
+		var strs []string
+		for _, item := range typedVal {
+			str, ok := item.(string)
+			if !ok {
+				return compactValue(raw)
+			}
+			strs = append(strs, str)
+		}
+		return strings.Join(strs, "\n")
+	default:
+		return compactValue(raw)
+	}
+}
+
+func compactValue(raw json.RawMessage) string {
+	var buf bytes.Buffer
+	if json.Compact(&buf, raw) != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
@@ -0,0 +1,204 @@
+package jsonclient_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cppforlife/go-cli-ui/ui"
+	fakeui "github.com/cppforlife/go-cli-ui/ui/fakes"
+	"github.com/cppforlife/go-cli-ui/ui/jsonclient"
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+type thing struct {
+	Name    string    `table:"Name"`
+	Count   int       `table:"Count"`
+	Ready   bool      `table:"Ready"`
+	Created time.Time `table:",key=created"`
+	Tags    []string  `table:"Tags"`
+	Ignored string    `table:"-"`
+	Other   string
+}
+
+func TestParse(t *testing.T) {
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	output := func(typed bool) []byte {
+		parentUI := &fakeui.FakeUI{}
+
+		var jsonUI *ui.JSONUI
+		if typed {
+			jsonUI = ui.NewTypedJSONUI(parentUI, ui.NewNoopLogger())
+		} else {
+			jsonUI = ui.NewJSONUI(parentUI, ui.NewNoopLogger())
+		}
+
+		jsonUI.PrintTable(Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"), NewHeader("Count"), NewHeader("Ready"),
+				NewHeader("Created"), NewHeader("Tags"), NewHeader("Ignored"),
+			},
+			Rows: [][]Value{
+				{
+					ValueString{S: "a"}, ValueInt{I: 3}, ValueBool{B: true},
+					ValueTime{T: created}, ValueStrings{S: []string{"t1", "t2"}}, ValueString{S: "i"},
+				},
+				{
+					ValueString{S: "b"}, ValueNone{}, ValueBool{B: false},
+					ValueTime{}, ValueStrings{}, ValueString{S: "i"},
+				},
+			},
+		})
+		jsonUI.Flush()
+
+		return []byte(parentUI.Blocks[0])
+	}
+
+	for _, typed := range []bool{false, true} {
+		t.Run("decodes rows into structs", func(t *testing.T) {
+			doc, err := jsonclient.Parse(output(typed))
+			assert.Equal(t, err, nil)
+			assert.Equal(t, doc.SchemaVersion, ui.JSONUISchemaVersion)
+			assert.Equal(t, doc.Tables[0].Keys(), []string{"name", "count", "ready", "created", "tags", "ignored"})
+
+			var things []thing
+
+			err = doc.Tables[0].DecodeRows(&things)
+			assert.Equal(t, err, nil)
+			assert.Equal(t, things, []thing{
+				{Name: "a", Count: 3, Ready: true, Created: created, Tags: []string{"t1", "t2"}},
+				{Name: "b"},
+			})
+		})
+
+		t.Run("returns string rows", func(t *testing.T) {
+			doc, err := jsonclient.Parse(output(typed))
+			assert.Equal(t, err, nil)
+			assert.Equal(t, doc.Tables[0].StringRows(), []map[string]string{
+				{
+					"name": "a", "count": "3", "ready": "true", "created": "2020-01-02T03:04:05Z",
+					"tags": "t1\nt2", "ignored": "i",
+				},
+				{
+					"name": "b", "count": "", "ready": "false", "created": "",
+					"tags": "", "ignored": "i",
+				},
+			})
+		})
+	}
+
+	t.Run("accepts output without schema version", func(t *testing.T) {
+		doc, err := jsonclient.Parse([]byte(`{"Tables": [{"Header": {"b": "B", "a": "A"}, "Rows": [{"a": "1"}]}]}`))
+		assert.Equal(t, err, nil)
+		assert.Equal(t, doc.Tables[0].Keys(), []string{"a", "b"})
+		assert.Equal(t, doc.Tables[0].Rows[0].String("a"), "1")
+	})
+
+	t.Run("returns error for unsupported schema version", func(t *testing.T) {
+		_, err := jsonclient.Parse([]byte(`{"SchemaVersion": "100"}`))
+		assert.Equal(t, err.Error(), "Unsupported JSON UI schema version '100' (supported: 1)")
+	})
+
+	t.Run("returns error when value cannot be decoded", func(t *testing.T) {
+		doc, err := jsonclient.Parse([]byte(`{"Tables": [{"Rows": [{"count": "many"}]}]}`))
+		assert.Equal(t, err, nil)
+
+		var things []thing
+
+		err = doc.Tables[0].DecodeRows(&things)
+		assert.Equal(t, err.Error(), "Decoding row 0: Decoding column 'count' into field 'Count': "+
+			"invalid character 'm' looking for beginning of value")
+	})
+
+	t.Run("returns error when destination is not a slice", func(t *testing.T) {
+		err := jsonclient.Table{}.DecodeRows(thing{})
+		assert.Equal(t, err.Error(), "Expected pointer to a slice of structs, but was 'jsonclient_test.thing'")
+	})
+}
+
+func TestJSONUISchema(t *testing.T) {
+	t.Run("is valid JSON matching current schema version", func(t *testing.T) {
+		var schema struct {
+			Properties struct {
+				SchemaVersion struct {
+					Const string
+				}
+			}
+		}
+
+		err := json.Unmarshal(ui.JSONUISchema, &schema)
+		assert.Equal(t, err, nil)
+		assert.Equal(t, schema.Properties.SchemaVersion.Const, ui.JSONUISchemaVersion)
+	})
+
+	t.Run("describes fields of JSON UI output", func(t *testing.T) {
+		type objectSchema struct {
+			Properties map[string]json.RawMessage
+			Required   []string
+		}
+
+		var schema struct {
+			objectSchema
+			Definitions map[string]objectSchema
+		}
+
+		err := json.Unmarshal(ui.JSONUISchema, &schema)
+		assert.Equal(t, err, nil)
+
+		checkFields := func(obj map[string]interface{}, schema objectSchema) {
+			for key := range obj {
+				assert.Contains(t, schema.Properties, key)
+			}
+			for _, key := range schema.Required {
+				assert.Contains(t, obj, key)
+			}
+		}
+
+		parentUI := &fakeui.FakeUI{}
+		jsonUI := ui.NewJSONUI(parentUI, ui.NewNoopLogger())
+
+		jsonUI.PrintLinef("line")
+		jsonUI.PrintErrorBlock("block")
+		jsonUI.PrintError(fmt.Errorf("Deploying: %w", fmt.Errorf("Invalid value")))
+		jsonUI.PrintTable(Table{
+			Content: "things",
+			Title:   "Things",
+			Header: []Header{
+				NewHeader("Name"), NewHeader("Size"), NewHeader("State"),
+				{Key: "url", Title: "URL", Wide: true}, {Key: "id", Title: "ID", Hidden: true},
+			},
+			Rows: [][]Value{
+				{
+					ValueString{S: "a"}, ValueBytes{B: 1024}, ValueStatus{Level: StatusOK, Text: "running"},
+					ValueLink{Text: "a", URL: "https://example.com/a"}, ValueString{S: "1"},
+				},
+			},
+			Footer: []FooterRow{{Aggregates: []ColumnAggregate{{Column: 1, Func: AggregateSum}}}},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Notes:  []string{"note"},
+		})
+		jsonUI.Flush()
+
+		var output map[string]interface{}
+
+		err = json.Unmarshal([]byte(parentUI.Blocks[0]), &output)
+		assert.Equal(t, err, nil)
+		checkFields(output, schema.objectSchema)
+
+		for _, table := range output["Tables"].([]interface{}) {
+			checkFields(table.(map[string]interface{}), schema.Definitions["table"])
+
+			for _, column := range table.(map[string]interface{})["Columns"].([]interface{}) {
+				checkFields(column.(map[string]interface{}), schema.Definitions["column"])
+			}
+		}
+
+		for _, outputErr := range output["Errors"].([]interface{}) {
+			checkFields(outputErr.(map[string]interface{}), schema.Definitions["error"])
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package jsonclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cppforlife/go-cli-ui/ui/table"
+)
+
+func decodeRows(rows []Row, dst interface{}) error {
+	dstVal := reflect.ValueOf(dst)
+
+	if dstVal.Kind() != reflect.Ptr || dstVal.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("Expected pointer to a slice of structs, but was '%T'", dst)
+	}
+
+	sliceVal := dstVal.Elem()
+	sliceVal.Set(reflect.MakeSlice(sliceVal.Type(), 0, len(rows)))
+
+	for i, row := range rows {
+		itemVal := reflect.New(sliceVal.Type().Elem())
+
+		err := decodeRow(row, itemVal.Interface())
+		if err != nil {
+			return fmt.Errorf("Decoding row %d: %s", i, err)
+		}
+
+		sliceVal.Set(reflect.Append(sliceVal, itemVal.Elem()))
+	}
+
+	return nil
+}
+
+func decodeRow(row Row, dst interface{}) error {
+	dstVal := reflect.ValueOf(dst)
+
+	if dstVal.Kind() != reflect.Ptr || dstVal.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("Expected pointer to a struct, but was '%T'", dst)
+	}
+
+	structVal := dstVal.Elem()
+	structType := structVal.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		tagVal, found := field.Tag.Lookup(table.StructTagName)
+		if !found || len(field.PkgPath) > 0 {
+			continue
+		}
+
+		tag, err := table.ParseStructTag(tagVal)
+		if err != nil {
+			return fmt.Errorf("Parsing tag of field '%s': %s", field.Name, err)
+		}
+
+		if tag.Skip {
+			continue
+		}
+
+		raw, found := row[tag.Key]
+		if !found {
+			continue
+		}
+
+		err = decodeValue(raw, structVal.Field(i))
+		if err != nil {
+			return fmt.Errorf("Decoding column '%s' into field '%s': %s", tag.Key, field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// decodeValue decodes both typed values and their string representations
+func decodeValue(raw json.RawMessage, val reflect.Value) error {
+	if string(raw) == "null" {
+		return nil
+	}
+
+	if val.Kind() == reflect.String {
+		val.SetString(stringValue(raw))
+		return nil
+	}
+
+	err := json.Unmarshal(raw, val.Addr().Interface())
+	if err == nil {
+		// Empty slices are decoded the same as in non-typed output
+		if val.Kind() == reflect.Slice && val.Len() == 0 {
+			val.Set(reflect.Zero(val.Type()))
+		}
+		return nil
+	}
+
+	var str string
+
+	if json.Unmarshal(raw, &str) != nil {
+		return err
+	}
+
+	// Values from non-typed output are always strings
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Bool:
+		if len(str) == 0 {
+			return nil
+		}
+		return json.Unmarshal([]byte(str), val.Addr().Interface())
+
+	case reflect.Slice:
+		if val.Type().Elem().Kind() != reflect.String {
+			return err
+		}
+		if len(str) > 0 {
+			val.Set(reflect.ValueOf(strings.Split(str, "\n")).Convert(val.Type()))
+		}
+		return nil
+
+	default:
+		if len(str) == 0 {
+			return nil
+		}
+		return err
+	}
+}
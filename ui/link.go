@@ -0,0 +1,22 @@
+package ui
+
+import (
+	"os"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+)
+
+// FormatLink formats link for use in lines (e.g. PrintLinef)
+// as a terminal hyperlink if UI supports it or as "text (url)" otherwise
+func FormatLink(ui UI, text, url string) string {
+	if formatter, ok := ui.(LinkFormatter); ok {
+		return formatter.FormatLink(text, url)
+	}
+	return ValueLink{Text: text, URL: url}.Format(LinkStylePlain)
+}
+
+// supportsHyperlinks checks whether terminal is capable of showing hyperlinks
+// (terminals that do not support OSC 8 sequences typically ignore them)
+func supportsHyperlinks(isTTY bool) bool {
+	return isTTY && os.Getenv("TERM") != "dumb"
+}
@@ -0,0 +1,52 @@
+package ui_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui"
+	fakeui "github.com/cppforlife/go-cli-ui/ui/fakes"
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+type hyperlinkUI struct {
+	fakeui.FakeUI
+}
+
+func (ui *hyperlinkUI) FormatLink(text, url string) string { return "<" + text + "|" + url + ">" }
+
+func TestFormatLink(t *testing.T) {
+	t.Run("returns text followed by URL when UI does not format links", func(t *testing.T) {
+		assert.Equal(t, FormatLink(&fakeui.FakeUI{}, "docs", "https://d"), "docs (https://d)")
+	})
+
+	t.Run("delegates to parent UI through wrapping UIs", func(t *testing.T) {
+		parentUI := &hyperlinkUI{}
+		ui := NewWrappingConfUI(NewPaddingUI(NewColorUI(NewIndentingUI(parentUI))), NewNoopLogger())
+
+		assert.Equal(t, FormatLink(ui, "docs", "https://d"), "<docs|https://d>")
+	})
+
+	t.Run("returns text followed by URL for non-TTY UI", func(t *testing.T) {
+		ui := NewNonTTYUI(&hyperlinkUI{})
+		assert.Equal(t, FormatLink(ui, "docs", "https://d"), "docs (https://d)")
+	})
+
+	t.Run("returns text followed by URL when writer is not a terminal", func(t *testing.T) {
+		ui := NewWriterUI(bytes.NewBufferString(""), bytes.NewBufferString(""), NewNoopLogger())
+		assert.Equal(t, FormatLink(ui, "docs", "https://d"), "docs (https://d)")
+	})
+
+	t.Run("prints links in tables as text followed by URL when writer is not a terminal", func(t *testing.T) {
+		outBuf := bytes.NewBufferString("")
+		ui := NewWriterUI(outBuf, bytes.NewBufferString(""), NewNoopLogger())
+
+		ui.PrintTable(Table{
+			Header:    []Header{NewHeader("Link")},
+			Rows:      [][]Value{{ValueLink{Text: "docs", URL: "https://d"}}},
+			BorderStr: "|",
+		})
+		assert.Equal(t, outBuf.String(), "Link|\ndocs (https://d)|\n")
+	})
+}
@@ -0,0 +1,322 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+)
+
+// StartLiveTable prints table and returns a handle to update it.
+// UIs that cannot update tables (see LiveTableUI) print each update.
+func StartLiveTable(ui UI, table Table) LiveTable {
+	if liveUI, ok := ui.(LiveTableUI); ok {
+		return liveUI.StartLiveTable(table)
+	}
+	ui.PrintTable(table)
+	return printingLiveTable{ui}
+}
+
+type printingLiveTable struct {
+	ui UI
+}
+
+func (t printingLiveTable) Update(table Table) { t.ui.PrintTable(table) }
+func (t printingLiveTable) Stop()              {}
+
+type noopLiveTable struct{}
+
+func (noopLiveTable) Update(Table) {}
+func (noopLiveTable) Stop()        {}
+
+// mappingLiveTable configures each update the same way
+// as wrapping UI configures printed tables
+type mappingLiveTable struct {
+	parent  LiveTable
+	mapFunc func(Table) (Table, error)
+	errFunc func(error)
+}
+
+func startMappingLiveTable(parent UI, table Table,
+	mapFunc func(Table) (Table, error), errFunc func(error)) LiveTable {
+
+	table, err := mapFunc(table)
+	if err != nil {
+		errFunc(err)
+		return noopLiveTable{}
+	}
+
+	return mappingLiveTable{
+		parent:  StartLiveTable(parent, table),
+		mapFunc: mapFunc,
+		errFunc: errFunc,
+	}
+}
+
+func (t mappingLiveTable) Update(table Table) {
+	table, err := t.mapFunc(table)
+	if err != nil {
+		t.errFunc(err)
+		return
+	}
+	t.parent.Update(table)
+}
+
+func (t mappingLiveTable) Stop() { t.parent.Stop() }
+
+const (
+	liveTableNoWrap = "\x1b[?7l"
+	liveTableWrap   = "\x1b[?7h"
+)
+
+// writerLiveTable redraws table in place on a TTY highlighting changed cells;
+// otherwise only new and changed rows are printed
+type writerLiveTable struct {
+	ui    *WriterUI
+	isTTY bool
+
+	prevCells    map[string]map[string]string
+	printedLines int
+	stopped      bool
+
+	mutex sync.Mutex
+}
+
+func (ui *WriterUI) StartLiveTable(table Table) LiveTable {
+	liveTable := &writerLiveTable{ui: ui, isTTY: ui.IsTTY()}
+	liveTable.Update(table)
+	return liveTable
+}
+
+func (t *writerLiveTable) Update(table Table) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.stopped {
+		return
+	}
+
+	table = t.ui.configureTable(table)
+
+	var changedTable Table
+	var hasChanges bool
+
+	if t.prevCells == nil || t.isTTY {
+		changedTable, hasChanges = t.highlightChanges(table), true
+	} else {
+		changedTable, hasChanges = t.changedRows(table)
+	}
+
+	t.prevCells = liveTableCells(table)
+
+	if !hasChanges {
+		return
+	}
+
+	buf := bytes.NewBufferString("")
+
+	err := changedTable.Print(buf)
+	if err != nil {
+		t.ui.logger.Error(t.ui.logTag, "LiveTable.Update failed: %s", err)
+		return
+	}
+
+	output := buf.String()
+
+	if t.isTTY {
+		if t.printedLines > 0 {
+			// Move cursor to the beginning of previously printed table and clear it
+			output = fmt.Sprintf("\x1b[%dF\x1b[J", t.printedLines) + output
+		}
+
+		// Rows wider than a terminal are cut off instead of wrapped
+		// so that each printed line takes exactly one terminal line
+		output = liveTableNoWrap + output + liveTableWrap
+	}
+
+	t.printedLines = strings.Count(buf.String(), "\n")
+
+	_, err = fmt.Fprint(t.ui.outWriter, output)
+	if err != nil {
+		t.ui.logger.Error(t.ui.logTag, "LiveTable.Update failed: %s", err)
+	}
+}
+
+func (t *writerLiveTable) Stop() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.stopped = true
+}
+
+// highlightLiveTableCell uses reverse video since live tables
+// are only redrawn in terminals
+func highlightLiveTableCell(pattern string, args ...interface{}) string {
+	return "\x1b[7m" + fmt.Sprintf(pattern, args...) + "\x1b[27m"
+}
+
+// highlightLiveTableValue keeps existing formatting of a value
+// (e.g. colors set by ColorUI) and highlights it on top
+func highlightLiveTableValue(val Value) Value {
+	switch typedVal := val.(type) {
+	case ValueFmt:
+		typedVal.Func = withLiveTableHighlight(typedVal.Func)
+		return typedVal
+	case ValueStatus:
+		typedVal.Func = withLiveTableHighlight(typedVal.Func)
+		return typedVal
+	default:
+		return ValueFmt{V: val, Func: highlightLiveTableCell}
+	}
+}
+
+func withLiveTableHighlight(formatFunc func(string, ...interface{}) string) func(string, ...interface{}) string {
+	if formatFunc == nil {
+		return highlightLiveTableCell
+	}
+	return func(pattern string, args ...interface{}) string {
+		return highlightLiveTableCell("%s", formatFunc(pattern, args...))
+	}
+}
+
+// highlightChanges wraps cells that changed since previous update
+func (t *writerLiveTable) highlightChanges(table Table) Table {
+	if t.prevCells == nil {
+		return table
+	}
+
+	keys := liveTableRowKeys{}
+
+	mapRow := func(firstCol Value, row []Value) []Value {
+		rowKey := keys.Next(firstCol, row)
+		prevRow, found := t.prevCells[rowKey]
+
+		newRow := append([]Value{}, row...)
+
+		for i, val := range newRow {
+			if i >= len(table.Header) || val == nil {
+				continue
+			}
+			if i == 0 && firstCol != nil {
+				continue
+			}
+			if prevVal, ok := prevRow[table.Header[i].Key]; !found || !ok || prevVal != val.String() {
+				newRow[i] = highlightLiveTableValue(val)
+			}
+		}
+
+		return newRow
+	}
+
+	table.Sections = append([]Section{}, table.Sections...)
+
+	for i, section := range table.Sections {
+		var rows [][]Value
+		for _, row := range section.Rows {
+			rows = append(rows, mapRow(section.FirstColumn, row))
+		}
+		table.Sections[i].Rows = rows
+	}
+
+	var rows [][]Value
+	for _, row := range table.Rows {
+		rows = append(rows, mapRow(nil, row))
+	}
+	table.Rows = rows
+
+	return table
+}
+
+// changedRows returns table that only includes new or changed rows
+func (t *writerLiveTable) changedRows(table Table) (Table, bool) {
+	keys := liveTableRowKeys{}
+	cells := liveTableCells(table)
+
+	var rows [][]Value
+
+	addRow := func(firstCol Value, row []Value) {
+		rowKey := keys.Next(firstCol, row)
+		prevRow, found := t.prevCells[rowKey]
+
+		if !found || !reflect.DeepEqual(prevRow, cells[rowKey]) {
+			row = append([]Value{}, row...)
+			if firstCol != nil && len(row) > 0 {
+				row[0] = firstCol
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	for _, section := range table.Sections {
+		for _, row := range section.Rows {
+			addRow(section.FirstColumn, row)
+		}
+	}
+	for _, row := range table.Rows {
+		addRow(nil, row)
+	}
+
+	table.Title = ""
+	table.Notes = nil
+	table.Content = ""
+	table.Sections = nil
+	table.Rows = rows
+	table.Footer = nil
+
+	return table, len(rows) > 0
+}
+
+// liveTableCells returns string values keyed by row key and header key
+func liveTableCells(table Table) map[string]map[string]string {
+	keys := liveTableRowKeys{}
+	result := map[string]map[string]string{}
+
+	addRow := func(firstCol Value, row []Value) {
+		cells := map[string]string{}
+		for i, val := range row {
+			if i >= len(table.Header) {
+				continue
+			}
+			if i == 0 && firstCol != nil {
+				val = firstCol
+			}
+			if val != nil {
+				cells[table.Header[i].Key] = val.String()
+			}
+		}
+		result[keys.Next(firstCol, row)] = cells
+	}
+
+	for _, section := range table.Sections {
+		for _, row := range section.Rows {
+			addRow(section.FirstColumn, row)
+		}
+	}
+	for _, row := range table.Rows {
+		addRow(nil, row)
+	}
+
+	return result
+}
+
+// liveTableRowKeys identifies rows by their first column
+// (repeated values are distinguished by their occurrence)
+type liveTableRowKeys map[string]int
+
+func (k liveTableRowKeys) Next(firstCol Value, row []Value) string {
+	var key string
+
+	switch {
+	case firstCol != nil:
+		key = firstCol.String()
+	case len(row) > 0 && row[0] != nil:
+		key = row[0].String()
+	}
+
+	k[key]++
+
+	return fmt.Sprintf("%s/%d", key, k[key])
+}
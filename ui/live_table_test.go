@@ -0,0 +1,246 @@
+package ui_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui"
+	fakeui "github.com/cppforlife/go-cli-ui/ui/fakes"
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiveTable(t *testing.T) {
+	buildTable := func(rows ...[]Value) Table {
+		return Table{
+			Title:     "Title",
+			Header:    []Header{NewHeader("Name"), NewHeader("State")},
+			Rows:      rows,
+			BorderStr: " ",
+		}
+	}
+
+	t.Run("WriterUI", func(t *testing.T) {
+		t.Run("prints only new and changed rows when not a TTY", func(t *testing.T) {
+			uiOut := bytes.NewBufferString("")
+			ui := NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger())
+			ui.OverrideTTY(false)
+
+			liveTable := StartLiveTable(ui, buildTable(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+				[]Value{ValueString{S: "b"}, ValueString{S: "running"}},
+			))
+
+			assert.Equal(t, uiOut.String(), "Title\n\nName State \na    running \nb    running \n")
+			uiOut.Reset()
+
+			liveTable.Update(buildTable(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+				[]Value{ValueString{S: "b"}, ValueString{S: "stopped"}},
+				[]Value{ValueString{S: "c"}, ValueString{S: "running"}},
+			))
+
+			assert.Equal(t, uiOut.String(), "Name State \nb    stopped \nc    running \n")
+			uiOut.Reset()
+
+			liveTable.Update(buildTable(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+			))
+
+			assert.Equal(t, uiOut.String(), "")
+		})
+
+		t.Run("redraws table in place highlighting changed cells when a TTY", func(t *testing.T) {
+			uiOut := bytes.NewBufferString("")
+			ui := NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger())
+			ui.OverrideTTY(true)
+
+			liveTable := StartLiveTable(ui, buildTable(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+			))
+
+			assert.Equal(t, uiOut.String(), "\x1b[?7l"+"Title\n\nName State \na    running \n"+"\x1b[?7h")
+			uiOut.Reset()
+
+			liveTable.Update(buildTable(
+				[]Value{ValueString{S: "a"}, ValueString{S: "stopped"}},
+			))
+
+			assert.Equal(t, uiOut.String(),
+				"\x1b[?7l"+"\x1b[4F\x1b[J"+"Title\n\nName State \na    \x1b[7mstopped\x1b[27m \n"+"\x1b[?7h")
+		})
+
+		t.Run("highlights changed cells keeping their formatting", func(t *testing.T) {
+			uiOut := bytes.NewBufferString("")
+			ui := NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger())
+			ui.OverrideTTY(true)
+
+			boldFunc := func(pattern string, args ...interface{}) string {
+				return "<b>" + fmt.Sprintf(pattern, args...) + "</b>"
+			}
+
+			liveTable := StartLiveTable(ui, buildTable(
+				[]Value{ValueFmt{V: ValueString{S: "a"}, Func: boldFunc}, ValueStatus{Level: StatusOK, Text: "running", Func: boldFunc}},
+			))
+			uiOut.Reset()
+
+			liveTable.Update(buildTable(
+				[]Value{ValueFmt{V: ValueString{S: "b"}, Func: boldFunc}, ValueStatus{Level: StatusError, Text: "failed", Func: boldFunc}},
+			))
+
+			assert.Equal(t, uiOut.String(),
+				"\x1b[?7l"+"\x1b[4F\x1b[J"+"Title\n\nName State \n"+
+					"\x1b[7m<b>b</b>\x1b[27m    \x1b[7m<b>failed</b>\x1b[27m \n"+"\x1b[?7h")
+		})
+
+		t.Run("highlights formatted cells of sorted tables", func(t *testing.T) {
+			uiOut := bytes.NewBufferString("")
+			ui := NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger())
+			ui.OverrideTTY(true)
+
+			sortedTable := func(state string) Table {
+				table := buildTable(
+					[]Value{ValueString{S: "b"}, ValueFmt{V: ValueString{S: "running"}}},
+					[]Value{ValueString{S: "a"}, ValueFmt{V: ValueString{S: state}}},
+				)
+				table.SortBy = []ColumnSort{{Column: 1, Asc: true}, {Column: 0, Asc: true}}
+				return table
+			}
+
+			liveTable := StartLiveTable(ui, sortedTable("running"))
+			uiOut.Reset()
+
+			liveTable.Update(sortedTable("stopped"))
+
+			assert.Equal(t, uiOut.String(),
+				"\x1b[?7l"+"\x1b[5F\x1b[J"+"Title\n\nName State \nb    running \na    \x1b[7mstopped\x1b[27m \n"+"\x1b[?7h")
+		})
+
+		t.Run("redraws rows wider than a terminal without wrapping them", func(t *testing.T) {
+			uiOut := bytes.NewBufferString("")
+			ui := NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger())
+			ui.OverrideTTY(true)
+
+			wideVal := ValueString{S: strings.Repeat("x", 500)}
+
+			liveTable := StartLiveTable(ui, buildTable(
+				[]Value{ValueString{S: "a"}, wideVal},
+			))
+			uiOut.Reset()
+
+			liveTable.Update(buildTable(
+				[]Value{ValueString{S: "b"}, wideVal},
+			))
+
+			// Autowrap is disabled so wide row takes a single line
+			assert.Equal(t, strings.HasPrefix(uiOut.String(), "\x1b[?7l"+"\x1b[4F\x1b[J"), true)
+			assert.Equal(t, strings.HasSuffix(uiOut.String(), "\n"+"\x1b[?7h"), true)
+		})
+
+		t.Run("does not print updates after stopping", func(t *testing.T) {
+			uiOut := bytes.NewBufferString("")
+			ui := NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger())
+
+			liveTable := StartLiveTable(ui, buildTable())
+			uiOut.Reset()
+
+			liveTable.Stop()
+			liveTable.Update(buildTable([]Value{ValueString{S: "a"}, ValueString{S: "running"}}))
+
+			assert.Equal(t, uiOut.String(), "")
+		})
+	})
+
+	t.Run("JSONUI", func(t *testing.T) {
+		t.Run("prints each update as a separate snapshot", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewJSONUI(parentUI, NewRecordingLogger())
+
+			liveTable := StartLiveTable(ui, buildTable(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+			))
+			liveTable.Update(buildTable(
+				[]Value{ValueString{S: "a"}, ValueString{S: "stopped"}},
+			))
+			liveTable.Stop()
+
+			assert.Equal(t, parentUI.Blocks, []string{
+				`{"SchemaVersion":"1","Tables":[{"Content":"","Title":"Title","Columns":[{"Key":"name","Title":"Name","Type":"string"},{"Key":"state","Title":"State","Type":"string"}],"Header":{"name":"Name","state":"State"},"Rows":[{"name":"a","state":"running"}],"Notes":null}],"Blocks":null,"Lines":null}` + "\n",
+				`{"SchemaVersion":"1","Tables":[{"Content":"","Title":"Title","Columns":[{"Key":"name","Title":"Name","Type":"string"},{"Key":"state","Title":"State","Type":"string"}],"Header":{"name":"Name","state":"State"},"Rows":[{"name":"a","state":"stopped"}],"Notes":null}],"Blocks":null,"Lines":null}` + "\n",
+			})
+		})
+		t.Run("prints snapshots and final response as a stream of JSON documents", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewJSONUI(parentUI, NewRecordingLogger())
+
+			ui.PrintLinef("Watching")
+			liveTable := StartLiveTable(ui, buildTable(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+			))
+			liveTable.Update(buildTable(
+				[]Value{ValueString{S: "a"}, ValueString{S: "stopped"}},
+			))
+			liveTable.Stop()
+			ui.Flush()
+
+			var resps []JSONUIResp
+
+			decoder := json.NewDecoder(strings.NewReader(strings.Join(parentUI.Blocks, "")))
+			for decoder.More() {
+				var resp JSONUIResp
+				assert.NoError(t, decoder.Decode(&resp))
+				resps = append(resps, resp)
+			}
+
+			assert.Equal(t, len(resps), 3)
+			assert.Equal(t, resps[0].Tables[0].Rows, []map[string]string{{"name": "a", "state": "running"}})
+			assert.Equal(t, resps[1].Tables[0].Rows, []map[string]string{{"name": "a", "state": "stopped"}})
+			assert.Equal(t, resps[2].Tables, []JSONUITableResp(nil))
+			assert.Equal(t, resps[2].Lines, []string{"Watching"})
+		})
+	})
+
+	t.Run("StartLiveTable", func(t *testing.T) {
+		t.Run("forwards to parent UI through wrapping UIs", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewPaddingUI(NewIndentingUI(NewNonInteractiveUI(parentUI)))
+
+			liveTable := StartLiveTable(ui, buildTable())
+			liveTable.Update(buildTable([]Value{ValueString{S: "a"}, ValueString{S: "running"}}))
+			liveTable.Stop()
+
+			assert.Equal(t, len(parentUI.LiveTables), 2)
+			assert.Equal(t, len(parentUI.Tables), 0)
+			assert.Equal(t, parentUI.LiveTableStopped, true)
+		})
+
+		t.Run("configures updates the same way as printed tables", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewNonTTYUI(parentUI)
+
+			liveTable := StartLiveTable(ui, buildTable())
+			liveTable.Update(buildTable())
+
+			assert.Equal(t, parentUI.LiveTables[1].Title, "")
+			assert.Equal(t, parentUI.LiveTables[1].BorderStr, "\t")
+		})
+
+		t.Run("prints each update when UI does not support live tables", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := plainUI{parentUI}
+
+			liveTable := StartLiveTable(ui, buildTable())
+			liveTable.Update(buildTable())
+
+			assert.Equal(t, len(parentUI.Tables), 2)
+		})
+	})
+}
+
+// plainUI hides optional capabilities of its parent
+type plainUI struct {
+	UI
+}
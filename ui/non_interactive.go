@@ -42,6 +42,26 @@ func (ui *NonInteractiveUI) PrintTable(table Table) {
 	ui.parent.PrintTable(table)
 }
 
+func (ui *NonInteractiveUI) StartLiveTable(table Table) LiveTable {
+	return StartLiveTable(ui.parent, table)
+}
+
+func (ui *NonInteractiveUI) PrintTableStream(table Table, rows RowIterator, opts StreamOpts) {
+	PrintTableStream(ui.parent, table, rows, opts)
+}
+
+func (ui *NonInteractiveUI) PrintError(err error) bool {
+	return forwardError(ui.parent, err)
+}
+
+func (ui *NonInteractiveUI) TerminalWidth() int {
+	return TerminalWidth(ui.parent)
+}
+
+func (ui *NonInteractiveUI) FormatLink(text, url string) string {
+	return FormatLink(ui.parent, text, url)
+}
+
 func (ui *NonInteractiveUI) AskForText(opts TextOpts) (string, error) {
 	if opts.ValidateFunc != nil {
 		isValid, message, err := opts.ValidateFunc(opts.Default)
@@ -24,6 +24,19 @@ func (ui *NonTTYUI) PrintBlock(block []byte)      { ui.parent.PrintBlock(block)
 func (ui *NonTTYUI) PrintErrorBlock(block string) { ui.parent.PrintErrorBlock(block) }
 
 func (ui *NonTTYUI) PrintTable(table Table) {
+	ui.parent.PrintTable(ui.plainTable(table))
+}
+
+func (ui *NonTTYUI) StartLiveTable(table Table) LiveTable {
+	mapFunc := func(table Table) (Table, error) { return ui.plainTable(table), nil }
+	return startMappingLiveTable(ui.parent, table, mapFunc, nil)
+}
+
+func (ui *NonTTYUI) PrintTableStream(table Table, rows RowIterator, opts StreamOpts) {
+	PrintTableStream(ui.parent, ui.plainTable(table), rows, opts)
+}
+
+func (ui *NonTTYUI) plainTable(table Table) Table {
 	// hide decorations
 	table.Title = ""
 	table.Notes = nil
@@ -35,8 +48,20 @@ func (ui *NonTTYUI) PrintTable(table Table) {
 
 	// cut's default delim
 	table.BorderStr = "\t"
+	table.BorderStyle = BorderStylePlain
+
+	// URLs are more useful than link texts when processing output
+	table.LinkStyle = LinkStyleURL
+
+	return table
+}
+
+func (ui *NonTTYUI) PrintError(err error) bool {
+	return forwardError(ui.parent, err)
+}
 
-	ui.parent.PrintTable(table)
+func (ui *NonTTYUI) FormatLink(text, url string) string {
+	return ValueLink{Text: text, URL: url}.Format(LinkStylePlain)
 }
 
 func (ui *NonTTYUI) AskForText(opts TextOpts) (string, error) {
@@ -127,6 +127,8 @@ func TestNonTTYUI(t *testing.T) {
 				DataOnly:        true,
 				BackgroundStr:   "-",
 				BorderStr:       "\t",
+				BorderStyle:     BorderStylePlain,
+				LinkStyle:       LinkStyleURL,
 			})
 		})
 	})
@@ -57,6 +57,28 @@ func (ui *PaddingUI) PrintTable(table Table) {
 	ui.parent.PrintTable(table)
 }
 
+func (ui *PaddingUI) StartLiveTable(table Table) LiveTable {
+	ui.padBefore(paddingUIModeAuto)
+	return StartLiveTable(ui.parent, table)
+}
+
+func (ui *PaddingUI) PrintTableStream(table Table, rows RowIterator, opts StreamOpts) {
+	ui.padBefore(paddingUIModeAuto)
+	PrintTableStream(ui.parent, table, rows, opts)
+}
+
+func (ui *PaddingUI) PrintError(err error) bool {
+	return forwardError(ui.parent, err)
+}
+
+func (ui *PaddingUI) TerminalWidth() int {
+	return TerminalWidth(ui.parent)
+}
+
+func (ui *PaddingUI) FormatLink(text, url string) string {
+	return FormatLink(ui.parent, text, url)
+}
+
 func (ui *PaddingUI) AskForText(opts TextOpts) (string, error) {
 	ui.padBefore(paddingUIModeAskText)
 	return ui.parent.AskForText(opts)
@@ -0,0 +1,129 @@
+package table
+
+// AggregateSum adds up numeric values (of the same type as the first one)
+func AggregateSum(vals []Value) Value {
+	var sum Value
+
+	for _, val := range vals {
+		if isEmptyValue(val) {
+			continue
+		}
+		if newSum, ok := addValues(sum, val.Value()); ok {
+			sum = newSum
+		}
+	}
+
+	if sum == nil {
+		return ValueNone{}
+	}
+	return sum
+}
+
+// addValues adds value to a sum (nil if nothing was added yet)
+func addValues(sum, val Value) (Value, bool) {
+	if sum == nil {
+		switch val.(type) {
+		case ValueInt, ValueInt64, ValueUint64, ValueFloat, ValuePercent, ValueBytes, ValueDuration:
+			return val, true
+		default:
+			return nil, false
+		}
+	}
+
+	switch typedSum := sum.(type) {
+	case ValueInt:
+		if typedVal, ok := val.(ValueInt); ok {
+			return ValueInt{I: typedSum.I + typedVal.I}, true
+		}
+	case ValueInt64:
+		if typedVal, ok := val.(ValueInt64); ok {
+			return ValueInt64{I: typedSum.I + typedVal.I}, true
+		}
+	case ValueUint64:
+		if typedVal, ok := val.(ValueUint64); ok {
+			return ValueUint64{I: typedSum.I + typedVal.I}, true
+		}
+	case ValueFloat:
+		if typedVal, ok := val.(ValueFloat); ok {
+			return ValueFloat{F: typedSum.F + typedVal.F, Precision: typedSum.Precision}, true
+		}
+	case ValuePercent:
+		if typedVal, ok := val.(ValuePercent); ok {
+			return ValuePercent{F: typedSum.F + typedVal.F}, true
+		}
+	case ValueBytes:
+		if typedVal, ok := val.(ValueBytes); ok {
+			return ValueBytes{B: typedSum.B + typedVal.B}, true
+		}
+	case ValueDuration:
+		if typedVal, ok := val.(ValueDuration); ok {
+			return ValueDuration{D: typedSum.D + typedVal.D}, true
+		}
+	}
+
+	return sum, false
+}
+
+// AggregateCount counts non-empty values
+func AggregateCount(vals []Value) Value {
+	var count int
+
+	for _, val := range vals {
+		if !isEmptyValue(val) {
+			count++
+		}
+	}
+
+	return ValueInt{I: count}
+}
+
+// AggregateMin picks smallest non-empty value
+func AggregateMin(vals []Value) Value {
+	return aggregateCompare(vals, -1)
+}
+
+// AggregateMax picks largest non-empty value
+func AggregateMax(vals []Value) Value {
+	return aggregateCompare(vals, 1)
+}
+
+// AggregateLabel always returns given string (e.g. "Total")
+func AggregateLabel(label string) AggregateFunc {
+	return func([]Value) Value { return ValueString{S: label} }
+}
+
+func aggregateCompare(vals []Value, expectedCmp int) Value {
+	var result Value
+
+	for _, val := range vals {
+		if isEmptyValue(val) {
+			continue
+		}
+		if result == nil || val.Value().Compare(result) == expectedCmp {
+			result = val.Value()
+		}
+	}
+
+	if result == nil {
+		return ValueNone{}
+	}
+	return result
+}
+
+func isEmptyValue(val Value) bool {
+	switch val.(type) {
+	case ValueNone, EmptyValue:
+		return true
+	default:
+		return len(val.String()) == 0
+	}
+}
+
+// blankValue is used for footer cells without aggregates
+// so that they are not printed as empty values (i.e. "-")
+type blankValue struct{}
+
+func (t blankValue) String() string          { return "" }
+func (t blankValue) Value() Value            { return t }
+func (t blankValue) Compare(other Value) int { panic("Never called") }
+func (t blankValue) JSONValue() interface{}  { return nil }
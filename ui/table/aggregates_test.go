@@ -0,0 +1,58 @@
+package table_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregates(t *testing.T) {
+	vals := []Value{
+		ValueInt{I: 3},
+		ValueNone{},
+		ValueFmt{V: ValueInt{I: 10}},
+		ValueInt{I: -2},
+	}
+
+	t.Run("AggregateSum adds up integers", func(t *testing.T) {
+		assert.Equal(t, AggregateSum(vals), ValueInt{I: 11})
+	})
+
+	t.Run("AggregateSum adds up values of the same type as the first one", func(t *testing.T) {
+		assert.Equal(t, AggregateSum([]Value{
+			ValueBytes{B: 1024}, ValueInt{I: 1}, ValueBytes{B: 512},
+		}), ValueBytes{B: 1536})
+
+		assert.Equal(t, AggregateSum([]Value{
+			ValueDuration{D: time.Second}, ValueDuration{D: time.Minute},
+		}), ValueDuration{D: 61 * time.Second})
+
+		assert.Equal(t, AggregateSum([]Value{
+			ValueFloat{F: 0.5, Precision: 1}, ValueFloat{F: 1.25},
+		}), ValueFloat{F: 1.75, Precision: 1})
+	})
+
+	t.Run("AggregateSum returns none when there are no integers", func(t *testing.T) {
+		assert.Equal(t, AggregateSum([]Value{ValueString{S: "a"}}), ValueNone{})
+	})
+
+	t.Run("AggregateCount counts non-empty values", func(t *testing.T) {
+		assert.Equal(t, AggregateCount(vals), ValueInt{I: 3})
+		assert.Equal(t, AggregateCount([]Value{ValueString{S: ""}, ValueString{S: "a"}}), ValueInt{I: 1})
+	})
+
+	t.Run("AggregateMin picks smallest value", func(t *testing.T) {
+		assert.Equal(t, AggregateMin(vals), ValueInt{I: -2})
+	})
+
+	t.Run("AggregateMax picks largest value", func(t *testing.T) {
+		assert.Equal(t, AggregateMax(vals), ValueInt{I: 10})
+		assert.Equal(t, AggregateMax([]Value{ValueNone{}}), ValueNone{})
+	})
+
+	t.Run("AggregateLabel returns label", func(t *testing.T) {
+		assert.Equal(t, AggregateLabel("Total")(vals), ValueString{S: "Total"})
+	})
+}
@@ -0,0 +1,136 @@
+package table
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+type BorderStyle string
+
+const (
+	// BorderStylePlain separates columns with BorderStr (default)
+	BorderStylePlain BorderStyle = "plain"
+	// BorderStyleCompact is plain style with a rule under the header
+	BorderStyleCompact BorderStyle = "compact"
+	// BorderStyleASCII draws a grid with ASCII characters
+	BorderStyleASCII BorderStyle = "ascii"
+	// BorderStyleLight, BorderStyleHeavy and BorderStyleRounded draw a frame
+	// with Unicode box-drawing characters (falls back to ASCII when
+	// terminal encoding is not UTF-8)
+	BorderStyleLight   BorderStyle = "light"
+	BorderStyleHeavy   BorderStyle = "heavy"
+	BorderStyleRounded BorderStyle = "rounded"
+)
+
+var borderStyles = []BorderStyle{
+	BorderStylePlain,
+	BorderStyleCompact,
+	BorderStyleASCII,
+	BorderStyleLight,
+	BorderStyleHeavy,
+	BorderStyleRounded,
+}
+
+type borderChars struct {
+	Horizontal string
+	Vertical   string
+
+	// Each corner/junction set is ordered as left, middle, right
+	Top    [3]string
+	Middle [3]string
+	Bottom [3]string
+
+	Framed        bool
+	HeaderRule    bool
+	RowSeparators bool
+	Unicode       bool
+}
+
+var asciiBorderChars = borderChars{
+	Horizontal: "-",
+	Vertical:   "|",
+	Top:        [3]string{"+", "+", "+"},
+	Middle:     [3]string{"+", "+", "+"},
+	Bottom:     [3]string{"+", "+", "+"},
+	Framed:     true,
+	HeaderRule: true,
+}
+
+var borderStyleChars = map[BorderStyle]borderChars{
+	BorderStylePlain:   {},
+	BorderStyleCompact: {HeaderRule: true},
+	BorderStyleASCII: func() borderChars {
+		chars := asciiBorderChars
+		chars.RowSeparators = true
+		return chars
+	}(),
+	BorderStyleLight: {
+		Horizontal: "─",
+		Vertical:   "│",
+		Top:        [3]string{"┌", "┬", "┐"},
+		Middle:     [3]string{"├", "┼", "┤"},
+		Bottom:     [3]string{"└", "┴", "┘"},
+		Framed:     true,
+		HeaderRule: true,
+		Unicode:    true,
+	},
+	BorderStyleHeavy: {
+		Horizontal: "━",
+		Vertical:   "┃",
+		Top:        [3]string{"┏", "┳", "┓"},
+		Middle:     [3]string{"┣", "╋", "┫"},
+		Bottom:     [3]string{"┗", "┻", "┛"},
+		Framed:     true,
+		HeaderRule: true,
+		Unicode:    true,
+	},
+	BorderStyleRounded: {
+		Horizontal: "─",
+		Vertical:   "│",
+		Top:        [3]string{"╭", "┬", "╮"},
+		Middle:     [3]string{"├", "┼", "┤"},
+		Bottom:     [3]string{"╰", "┴", "╯"},
+		Framed:     true,
+		HeaderRule: true,
+		Unicode:    true,
+	},
+}
+
+func ParseBorderStyle(str string) (BorderStyle, error) {
+	for _, style := range borderStyles {
+		if string(style) == str {
+			return style, nil
+		}
+	}
+
+	var names []string
+	for _, style := range borderStyles {
+		names = append(names, string(style))
+	}
+
+	return "", fmt.Errorf("Unknown border style '%s' (valid styles: %s)", str, strings.Join(names, ", "))
+}
+
+func (s BorderStyle) chars() borderChars {
+	chars, found := borderStyleChars[s]
+	if !found {
+		return borderChars{}
+	}
+	if chars.Unicode && !isUTF8Locale() {
+		return asciiBorderChars
+	}
+	return chars
+}
+
+// isUTF8Locale checks locale environment variables in order of precedence
+func isUTF8Locale() bool {
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		val := os.Getenv(name)
+		if len(val) > 0 {
+			val = strings.ToLower(val)
+			return strings.Contains(val, "utf-8") || strings.Contains(val, "utf8")
+		}
+	}
+	return false
+}
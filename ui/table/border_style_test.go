@@ -0,0 +1,190 @@
+package table_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBorderStyle(t *testing.T) {
+	t.Run("parses known styles", func(t *testing.T) {
+		style, err := ParseBorderStyle("rounded")
+		assert.NoError(t, err)
+		assert.Equal(t, style, BorderStyleRounded)
+	})
+
+	t.Run("returns error for unknown styles", func(t *testing.T) {
+		_, err := ParseBorderStyle("fancy")
+		assert.EqualError(t, err, "Unknown border style 'fancy' (valid styles: plain, compact, ascii, light, heavy, rounded)")
+	})
+}
+
+func TestTableBorderStyle(t *testing.T) {
+	printTable := func(table Table) string {
+		buf := bytes.NewBufferString("")
+		table.Print(buf)
+		return "\n" + buf.String()
+	}
+
+	t.Run("prints compact style with header rule", func(t *testing.T) {
+		assert.Equal(t, printTable(Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Rows: [][]Value{
+				{ValueString{S: "name1"}, ValueString{S: "1.0"}},
+				{ValueString{S: "name2"}, ValueString{S: "2.0\n2.1"}},
+			},
+			BorderStyle: BorderStyleCompact,
+		}), `
+Name   Version  
+-----  -------  
+name1  1.0  
+name2  2.0  
+       2.1  
+
+2 things
+`)
+	})
+
+	t.Run("prints ascii grid", func(t *testing.T) {
+		assert.Equal(t, printTable(Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Rows: [][]Value{
+				{ValueString{S: "name1"}, ValueString{S: "1.0"}},
+				{ValueString{S: "name2"}, ValueString{S: "2.0\n2.1"}},
+			},
+			BorderStyle: BorderStyleASCII,
+		}), `
++-------+---------+
+| Name  | Version |
++-------+---------+
+| name1 | 1.0     |
++-------+---------+
+| name2 | 2.0     |
+|       | 2.1     |
++-------+---------+
+
+2 things
+`)
+	})
+
+	t.Run("prints unicode frame when locale is UTF-8", func(t *testing.T) {
+		t.Setenv("LC_ALL", "")
+		t.Setenv("LC_CTYPE", "")
+		t.Setenv("LANG", "en_US.UTF-8")
+
+		assert.Equal(t, printTable(Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Rows: [][]Value{
+				{ValueString{S: "name1"}, ValueString{S: "1.0"}},
+				{ValueString{S: "name2"}, ValueString{S: "2.0\n2.1"}},
+			},
+			BorderStyle: BorderStyleLight,
+		}), `
+┌───────┬─────────┐
+│ Name  │ Version │
+├───────┼─────────┤
+│ name1 │ 1.0     │
+│ name2 │ 2.0     │
+│       │ 2.1     │
+└───────┴─────────┘
+
+2 things
+`)
+
+		assert.Equal(t, printTable(Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Rows: [][]Value{
+				{ValueString{S: "name1"}, ValueString{S: "1.0"}},
+				{ValueString{S: "name2"}, ValueString{S: "2.0\n2.1"}},
+			},
+			BorderStyle: BorderStyleRounded,
+		}), `
+╭───────┬─────────╮
+│ Name  │ Version │
+├───────┼─────────┤
+│ name1 │ 1.0     │
+│ name2 │ 2.0     │
+│       │ 2.1     │
+╰───────┴─────────╯
+
+2 things
+`)
+	})
+
+	t.Run("falls back to ascii frame when locale is not UTF-8", func(t *testing.T) {
+		t.Setenv("LC_ALL", "C")
+
+		assert.Equal(t, printTable(Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Rows: [][]Value{
+				{ValueString{S: "name1"}, ValueString{S: "1.0"}},
+				{ValueString{S: "name2"}, ValueString{S: "2.0\n2.1"}},
+			},
+			BorderStyle: BorderStyleHeavy,
+		}), `
++-------+---------+
+| Name  | Version |
++-------+---------+
+| name1 | 1.0     |
+| name2 | 2.0     |
+|       | 2.1     |
++-------+---------+
+
+2 things
+`)
+	})
+
+	t.Run("separates transposed records and footer inside of the frame", func(t *testing.T) {
+		table := Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Rows: [][]Value{
+				{ValueString{S: "name1"}, ValueString{S: "1.0"}},
+				{ValueString{S: "name2"}, ValueString{S: "2.0\n2.1"}},
+			},
+			BorderStyle: BorderStyleASCII,
+		}
+		table.Rows = table.Rows[:1]
+		table.Transpose = true
+		table.Footer = []FooterRow{{
+			Aggregates: []ColumnAggregate{{Column: 0, Func: AggregateCount}},
+		}}
+
+		assert.Equal(t, printTable(table), `
++---------+-------+
+| Name    | name1 |
++---------+-------+
+| Version | 1.0   |
++---------+-------+
+| Name    |     1 |
++---------+-------+
+
+1 things
+`)
+	})
+}
@@ -0,0 +1,180 @@
+package table
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	columnSelectorExclude = "-"
+	columnSelectorInclude = "+"
+)
+
+type UnknownColumnError struct {
+	Column    string
+	ValidKeys []string
+}
+
+func (e UnknownColumnError) Error() string {
+	return fmt.Sprintf("Unknown column '%s' (valid columns: %s)", e.Column, strings.Join(e.ValidKeys, ", "))
+}
+
+// SelectColumns shows columns matching given selectors in requested order.
+// Selectors match header key or title exactly or via glob (e.g. *_time).
+// Selectors prefixed with '-' hide matching columns and with '+' add
+// matching columns. If only such prefixed selectors are given, selection
+// starts from currently visible (and non-wide, unless wide columns
+// are shown) columns instead of an empty set.
+func (t *Table) SelectColumns(selectors []string) error {
+	var selected []int
+
+	onlyModifiers := true
+	for _, sel := range selectors {
+		if !strings.HasPrefix(sel, columnSelectorExclude) && !strings.HasPrefix(sel, columnSelectorInclude) {
+			onlyModifiers = false
+			break
+		}
+	}
+
+	if onlyModifiers {
+		for i, header := range t.Header {
+			if !header.Hidden && (!header.Wide || t.ShowWideColumns) {
+				selected = append(selected, i)
+			}
+		}
+	}
+
+	for _, sel := range selectors {
+		exclude := strings.HasPrefix(sel, columnSelectorExclude)
+		pattern := strings.TrimPrefix(strings.TrimPrefix(sel, columnSelectorExclude), columnSelectorInclude)
+
+		idxs, err := t.matchColumns(pattern)
+		if err != nil {
+			return err
+		}
+
+		if exclude {
+			selected = removeColumnIdxs(selected, idxs)
+		} else {
+			selected = appendColumnIdxs(selected, idxs)
+		}
+	}
+
+	t.showColumnIdxs(selected)
+
+	return nil
+}
+
+// ShowColumns shows columns that match given headers by key or title
+// in requested order (unlike SelectColumns, globs are not supported)
+func (t *Table) ShowColumns(headers []Header) error {
+	var selected []int
+
+	for _, header := range headers {
+		idx := -1
+		for i, tableHeader := range t.Header {
+			if (len(header.Key) > 0 && tableHeader.Key == header.Key) ||
+				(len(header.Title) > 0 && tableHeader.Title == header.Title) {
+				idx = i
+				break
+			}
+		}
+
+		if idx < 0 {
+			column := header.Key
+			if len(column) == 0 {
+				column = header.Title
+			}
+			return UnknownColumnError{Column: column, ValidKeys: t.headerKeys()}
+		}
+
+		selected = appendColumnIdxs(selected, []int{idx})
+	}
+
+	t.showColumnIdxs(selected)
+
+	return nil
+}
+
+// showColumnIdxs shows only given columns (even if they are wide)
+// moving them to the front in given order
+func (t *Table) showColumnIdxs(idxs []int) {
+	// Copy since header may be shared with caller's table
+	t.Header = append([]Header{}, t.Header...)
+
+	for i := range t.Header {
+		t.Header[i].Hidden = true
+	}
+	for _, idx := range idxs {
+		t.Header[idx].Hidden = false
+		t.Header[idx].Wide = false
+	}
+
+	t.reorderColumns(idxs)
+}
+
+func (t *Table) matchColumns(pattern string) ([]int, error) {
+	var idxs []int
+
+	if strings.ContainsAny(pattern, "*?[") {
+		for i, header := range t.Header {
+			matched, err := path.Match(pattern, header.Key)
+			if err != nil {
+				return nil, fmt.Errorf("Matching column '%s': %s", pattern, err)
+			}
+			if matched {
+				idxs = append(idxs, i)
+			}
+		}
+	} else if idx := t.headerIndex(pattern); idx >= 0 {
+		idxs = append(idxs, idx)
+	}
+
+	if len(idxs) == 0 {
+		return nil, UnknownColumnError{Column: pattern, ValidKeys: t.headerKeys()}
+	}
+
+	return idxs, nil
+}
+
+func (t *Table) headerKeys() []string {
+	var keys []string
+	for _, header := range t.Header {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
+
+func appendColumnIdxs(idxs []int, newIdxs []int) []int {
+	for _, newIdx := range newIdxs {
+		found := false
+		for _, idx := range idxs {
+			if idx == newIdx {
+				found = true
+				break
+			}
+		}
+		if !found {
+			idxs = append(idxs, newIdx)
+		}
+	}
+	return idxs
+}
+
+func removeColumnIdxs(idxs []int, removeIdxs []int) []int {
+	var result []int
+	for _, idx := range idxs {
+		found := false
+		for _, removeIdx := range removeIdxs {
+			if idx == removeIdx {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, idx)
+		}
+	}
+	return result
+}
@@ -0,0 +1,215 @@
+package table_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableSelectColumns(t *testing.T) {
+	visibleKeys := func(table Table) []string {
+		var keys []string
+		for _, header := range table.Header {
+			if !header.Hidden {
+				keys = append(keys, header.Key)
+			}
+		}
+		return keys
+	}
+
+	t.Run("shows columns in requested order", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Created Time"),
+				NewHeader("Updated Time"),
+				{Key: "cid", Title: "CID", Hidden: true},
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "name"}, ValueString{S: "created"}, ValueString{S: "updated"}, ValueString{S: "cid"}},
+			},
+		}
+
+		err := table.SelectColumns([]string{"cid", "Name"})
+		assert.NoError(t, err)
+		assert.Equal(t, visibleKeys(table), []string{"cid", "name"})
+		assert.Equal(t, table.Rows[0][:2], []Value{ValueString{S: "cid"}, ValueString{S: "name"}})
+		assert.Equal(t, table.SortBy, []ColumnSort{{Column: 1, Asc: true}})
+	})
+
+	t.Run("supports globs", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Created Time"),
+				NewHeader("Updated Time"),
+				{Key: "cid", Title: "CID", Hidden: true},
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "name"}, ValueString{S: "created"}, ValueString{S: "updated"}, ValueString{S: "cid"}},
+			},
+		}
+
+		err := table.SelectColumns([]string{"*_time", "name"})
+		assert.NoError(t, err)
+		assert.Equal(t, visibleKeys(table), []string{"created_time", "updated_time", "name"})
+	})
+
+	t.Run("supports negation of default columns", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Created Time"),
+				NewHeader("Updated Time"),
+				{Key: "cid", Title: "CID", Hidden: true},
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "name"}, ValueString{S: "created"}, ValueString{S: "updated"}, ValueString{S: "cid"}},
+			},
+		}
+
+		err := table.SelectColumns([]string{"-created_time"})
+		assert.NoError(t, err)
+		assert.Equal(t, visibleKeys(table), []string{"name", "updated_time"})
+	})
+
+	t.Run("supports adding extra columns to default columns", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Created Time"),
+				NewHeader("Updated Time"),
+				{Key: "cid", Title: "CID", Hidden: true},
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "name"}, ValueString{S: "created"}, ValueString{S: "updated"}, ValueString{S: "cid"}},
+			},
+		}
+
+		err := table.SelectColumns([]string{"+cid", "-*_time"})
+		assert.NoError(t, err)
+		assert.Equal(t, visibleKeys(table), []string{"name", "cid"})
+	})
+
+	t.Run("does not modify headers shared with original table", func(t *testing.T) {
+		origTable := Table{
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Created Time"),
+				NewHeader("Updated Time"),
+				{Key: "cid", Title: "CID", Hidden: true},
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "name"}, ValueString{S: "created"}, ValueString{S: "updated"}, ValueString{S: "cid"}},
+			},
+		}
+
+		origHeader := append([]Header{}, origTable.Header...)
+		origRow := append([]Value{}, origTable.Rows[0]...)
+
+		table := origTable
+		err := table.SelectColumns([]string{"cid", "-name"})
+		assert.NoError(t, err)
+
+		assert.Equal(t, origTable.Header, origHeader)
+		assert.Equal(t, origTable.Rows[0], origRow)
+	})
+
+	t.Run("moves footer aggregates along with their columns", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Header: []Header{NewHeader("Name"), NewHeader("Count"), NewHeader("Size")},
+			Rows: [][]Value{
+				{ValueString{S: "a"}, ValueInt{I: 1}, ValueBytes{B: 1024}},
+				{ValueString{S: "b"}, ValueInt{I: 2}, ValueBytes{B: 2048}},
+			},
+			Footer: []FooterRow{{
+				Aggregates: []ColumnAggregate{
+					{Column: 0, Func: AggregateLabel("Total")},
+					{Column: 1, Func: AggregateSum},
+					{Column: 2, Func: AggregateSum},
+				},
+			}},
+			BorderStr: "|",
+		}
+
+		err := table.SelectColumns([]string{"size", "name"})
+		assert.NoError(t, err)
+
+		table.Print(buf)
+		assert.Equal(t, "\n"+buf.String(), `
+Size |Name|
+1 KiB|a|
+2 KiB|b|
+-----|-----|
+3 KiB|Total|
+`)
+		assert.Equal(t, len(table.Footer[0].Aggregates), 2)
+	})
+
+	t.Run("returns error listing valid keys when column is unknown", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Created Time"),
+				NewHeader("Updated Time"),
+				{Key: "cid", Title: "CID", Hidden: true},
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "name"}, ValueString{S: "created"}, ValueString{S: "updated"}, ValueString{S: "cid"}},
+			},
+		}
+
+		err := table.SelectColumns([]string{"name", "*_date"})
+		assert.EqualError(t, err, "Unknown column '*_date' (valid columns: name, created_time, updated_time, cid)")
+		assert.Equal(t, err, UnknownColumnError{
+			Column:    "*_date",
+			ValidKeys: []string{"name", "created_time", "updated_time", "cid"},
+		})
+	})
+}
+
+func TestTableShowColumns(t *testing.T) {
+	t.Run("shows columns matching headers literally by key or title", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				NewHeader("Name"),
+				{Key: "-size", Title: "Size"},
+				{Key: "used", Title: "Used *"},
+				{Key: "cid", Title: "CID", Hidden: true},
+			},
+			Rows: [][]Value{
+				{ValueString{S: "name"}, ValueString{S: "size"}, ValueString{S: "used"}, ValueString{S: "cid"}},
+			},
+		}
+
+		err := table.ShowColumns([]Header{{Title: "Used *"}, {Key: "-size"}, {Key: "cid"}})
+		assert.NoError(t, err)
+		assert.Equal(t, table.Header, []Header{
+			{Key: "used", Title: "Used *"},
+			{Key: "-size", Title: "Size"},
+			{Key: "cid", Title: "CID"},
+			{Key: "name", Title: "Name", Hidden: true},
+		})
+		assert.Equal(t, table.Rows, [][]Value{
+			{ValueString{S: "used"}, ValueString{S: "size"}, ValueString{S: "cid"}, ValueString{S: "name"}},
+		})
+	})
+
+	t.Run("does not treat headers as selectors", func(t *testing.T) {
+		table := Table{
+			Header: []Header{NewHeader("Name"), NewHeader("Created Time")},
+		}
+
+		err := table.ShowColumns([]Header{{Key: "*_time"}})
+		assert.EqualError(t, err, "Unknown column '*_time' (valid columns: name, created_time)")
+	})
+}
@@ -0,0 +1,185 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+const customColumnsPrefix = "custom-columns="
+
+// ParseCustomColumns parses specification such as
+// "custom-columns=NAME:name,VER:version" (prefix is optional).
+// Title may be omitted (e.g. "name") to keep table's title.
+func ParseCustomColumns(spec string) ([]CustomColumn, error) {
+	spec = strings.TrimPrefix(strings.TrimSpace(spec), customColumnsPrefix)
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("Expected custom columns to be non-empty")
+	}
+
+	var cols []CustomColumn
+	seenKeys := map[string]struct{}{}
+
+	for _, piece := range strings.Split(spec, ",") {
+		var col CustomColumn
+
+		pieces := strings.SplitN(piece, ":", 2)
+		if len(pieces) == 2 {
+			col.Title = strings.TrimSpace(pieces[0])
+			col.Key = strings.TrimSpace(pieces[1])
+		} else {
+			col.Key = strings.TrimSpace(pieces[0])
+		}
+
+		if len(col.Key) == 0 {
+			return nil, fmt.Errorf("Expected custom column '%s' to specify a key", piece)
+		}
+		if _, found := seenKeys[col.Key]; found {
+			return nil, fmt.Errorf("Expected custom column key '%s' to be specified once", col.Key)
+		}
+		seenKeys[col.Key] = struct{}{}
+
+		cols = append(cols, col)
+	}
+
+	return cols, nil
+}
+
+// ApplyCustomColumns reorders and renames columns to match requested ones.
+// Columns that were not requested are moved to the end and hidden
+// so that SortBy may continue to refer to them.
+func (t *Table) ApplyCustomColumns(cols []CustomColumn) error {
+	if len(t.Header) == 0 {
+		return fmt.Errorf("Expected table to have a header to apply custom columns")
+	}
+
+	var order []int
+
+	for _, col := range cols {
+		idx := t.headerIndex(col.Key)
+		if idx < 0 {
+			return fmt.Errorf("Failed to find header: %s", col.Key)
+		}
+		order = append(order, idx)
+	}
+
+	t.showColumnIdxs(order)
+
+	// Requested columns are moved to the front
+	for i, col := range cols {
+		if len(col.Title) > 0 {
+			t.Header[i].Title = col.Title
+		}
+	}
+
+	return nil
+}
+
+func (t *Table) headerIndex(keyOrTitle string) int {
+	for i, header := range t.Header {
+		if header.Key == keyOrTitle {
+			return i
+		}
+	}
+	for i, header := range t.Header {
+		if header.Title == keyOrTitle {
+			return i
+		}
+	}
+	return -1
+}
+
+// reorderColumns moves specified columns to the front (in given order)
+// followed by the rest of the columns in their original order.
+func (t *Table) reorderColumns(order []int) {
+	perm := append([]int{}, order...)
+	used := map[int]struct{}{}
+	for _, idx := range order {
+		used[idx] = struct{}{}
+	}
+	for i := range t.Header {
+		if _, found := used[i]; !found {
+			perm = append(perm, i)
+		}
+	}
+
+	newHeader := make([]Header, len(perm))
+	for i, idx := range perm {
+		newHeader[i] = t.Header[idx]
+	}
+	t.Header = newHeader
+
+	newPos := map[int]int{}
+	for i, idx := range perm {
+		newPos[idx] = i
+	}
+
+	t.Sections = append([]Section{}, t.Sections...)
+
+	for i, section := range t.Sections {
+		rows := reorderRows(section.Rows, perm)
+
+		// First column may be moved so it needs to be materialized
+		if section.FirstColumn != nil && len(section.FirstColumn.String()) > 0 {
+			for j, row := range section.Rows {
+				if len(row) > 0 {
+					rows[j][newPos[0]] = section.FirstColumn
+				}
+			}
+			t.Sections[i].FirstColumn = nil
+		}
+
+		t.Sections[i].Rows = rows
+	}
+
+	t.Rows = reorderRows(t.Rows, perm)
+
+	var sortBy []ColumnSort
+	for _, cs := range t.SortBy {
+		cs.Column = newPos[cs.Column]
+		sortBy = append(sortBy, cs)
+	}
+	t.SortBy = sortBy
+
+	t.Footer = remapAggregates(t.Footer, newPos, t.Header)
+	t.GroupBy.Subtotals = remapAggregates(t.GroupBy.Subtotals, newPos, t.Header)
+}
+
+// remapAggregates moves aggregates along with their columns; aggregates
+// of hidden columns are dropped and of unknown columns are reported by Print
+func remapAggregates(footers []FooterRow, newPos map[int]int, header []Header) []FooterRow {
+	var result []FooterRow
+
+	for _, footer := range footers {
+		var aggs []ColumnAggregate
+		for _, agg := range footer.Aggregates {
+			if pos, found := newPos[agg.Column]; found {
+				if header[pos].Hidden {
+					continue
+				}
+				agg.Column = pos
+			}
+			aggs = append(aggs, agg)
+		}
+		result = append(result, FooterRow{Aggregates: aggs})
+	}
+
+	return result
+}
+
+func reorderRows(rows [][]Value, perm []int) [][]Value {
+	var result [][]Value
+
+	for _, row := range rows {
+		newRow := make([]Value, len(perm))
+		for i, idx := range perm {
+			if idx < len(row) {
+				newRow[i] = row[idx]
+			} else {
+				newRow[i] = ValueNone{}
+			}
+		}
+		result = append(result, newRow)
+	}
+
+	return result
+}
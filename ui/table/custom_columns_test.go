@@ -0,0 +1,196 @@
+package table_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCustomColumns(t *testing.T) {
+	t.Run("parses titles and keys", func(t *testing.T) {
+		cols, err := ParseCustomColumns("custom-columns=NAME:name,VER:version")
+		assert.NoError(t, err)
+		assert.Equal(t, cols, []CustomColumn{{Title: "NAME", Key: "name"}, {Title: "VER", Key: "version"}})
+	})
+
+	t.Run("allows to omit prefix and titles", func(t *testing.T) {
+		cols, err := ParseCustomColumns("name, VER:version")
+		assert.NoError(t, err)
+		assert.Equal(t, cols, []CustomColumn{{Key: "name"}, {Title: "VER", Key: "version"}})
+	})
+
+	t.Run("returns error for empty specification", func(t *testing.T) {
+		_, err := ParseCustomColumns("custom-columns=")
+		assert.EqualError(t, err, "Expected custom columns to be non-empty")
+	})
+
+	t.Run("returns error for missing key", func(t *testing.T) {
+		_, err := ParseCustomColumns("NAME:,VER:version")
+		assert.EqualError(t, err, "Expected custom column 'NAME:' to specify a key")
+	})
+
+	t.Run("returns error for duplicate keys", func(t *testing.T) {
+		_, err := ParseCustomColumns("name,NAME:name")
+		assert.EqualError(t, err, "Expected custom column key 'name' to be specified once")
+	})
+}
+
+func TestTableApplyCustomColumns(t *testing.T) {
+	t.Run("reorders, renames and subsets columns", func(t *testing.T) {
+		table := Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+				NewHeader("CID"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "b"}, ValueString{S: "1"}, ValueString{S: "cid1"}},
+				{ValueString{S: "a"}, ValueString{S: "2"}, ValueString{S: "cid2"}},
+			},
+			BorderStr: "|",
+		}
+
+		err := table.ApplyCustomColumns([]CustomColumn{{Title: "VER", Key: "version"}, {Key: "name"}})
+		assert.NoError(t, err)
+
+		buf := bytes.NewBufferString("")
+		table.Print(buf)
+		assert.Equal(t, "\n"+buf.String(), `
+VER|Name|
+2  |a|
+1  |b|
+
+2 things
+`)
+	})
+
+	t.Run("works with sections", func(t *testing.T) {
+		table := Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+				NewHeader("CID"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "b"}, ValueString{S: "1"}, ValueString{S: "cid1"}},
+				{ValueString{S: "a"}, ValueString{S: "2"}, ValueString{S: "cid2"}},
+			},
+			BorderStr: "|",
+		}
+		table.Rows = nil
+		table.SortBy = nil
+		table.Sections = []Section{
+			{
+				FirstColumn: ValueString{S: "s1"},
+				Rows: [][]Value{
+					{ValueNone{}, ValueString{S: "1"}, ValueString{S: "cid1"}},
+					{ValueNone{}, ValueString{S: "2"}, ValueString{S: "cid2"}},
+				},
+			},
+		}
+
+		err := table.ApplyCustomColumns([]CustomColumn{{Key: "cid"}, {Key: "name"}})
+		assert.NoError(t, err)
+
+		buf := bytes.NewBufferString("")
+		table.Print(buf)
+		assert.Equal(t, "\n"+buf.String(), `
+CID |Name|
+cid1|s1|
+cid2|s1|
+
+2 things
+`)
+	})
+
+	t.Run("works with transposed tables", func(t *testing.T) {
+		table := Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+				NewHeader("CID"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "b"}, ValueString{S: "1"}, ValueString{S: "cid1"}},
+				{ValueString{S: "a"}, ValueString{S: "2"}, ValueString{S: "cid2"}},
+			},
+			BorderStr: "|",
+			Transpose: true,
+		}
+
+		err := table.ApplyCustomColumns([]CustomColumn{{Key: "cid"}, {Title: "N", Key: "name"}})
+		assert.NoError(t, err)
+
+		buf := bytes.NewBufferString("")
+		table.Print(buf)
+		assert.Equal(t, "\n"+buf.String(), `
+CID|cid2|
+N  |a|
+
+CID|cid1|
+N  |b|
+
+2 things
+`)
+	})
+
+	t.Run("does not modify headers and rows shared with original table", func(t *testing.T) {
+		origTable := Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+				NewHeader("CID"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "b"}, ValueString{S: "1"}, ValueString{S: "cid1"}},
+				{ValueString{S: "a"}, ValueString{S: "2"}, ValueString{S: "cid2"}},
+			},
+			BorderStr: "|",
+		}
+		origTable.Rows = nil
+		origTable.Sections = []Section{
+			{
+				FirstColumn: ValueString{S: "s1"},
+				Rows:        [][]Value{{ValueNone{}, ValueString{S: "1"}, ValueString{S: "cid1"}}},
+			},
+		}
+
+		table := origTable
+		err := table.ApplyCustomColumns([]CustomColumn{{Title: "VER", Key: "version"}, {Key: "name"}})
+		assert.NoError(t, err)
+
+		assert.Equal(t, origTable.Header, []Header{NewHeader("Name"), NewHeader("Version"), NewHeader("CID")})
+		assert.Equal(t, origTable.Sections[0].FirstColumn, ValueString{S: "s1"})
+		assert.Equal(t, origTable.Sections[0].Rows[0], []Value{ValueNone{}, ValueString{S: "1"}, ValueString{S: "cid1"}})
+	})
+
+	t.Run("returns error when key is unknown", func(t *testing.T) {
+		table := Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+				NewHeader("CID"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "b"}, ValueString{S: "1"}, ValueString{S: "cid1"}},
+				{ValueString{S: "a"}, ValueString{S: "2"}, ValueString{S: "cid2"}},
+			},
+			BorderStr: "|",
+		}
+
+		err := table.ApplyCustomColumns([]CustomColumn{{Key: "unknown"}})
+		assert.EqualError(t, err, "Failed to find header: unknown")
+	})
+}
@@ -0,0 +1,124 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+)
+
+func (f NumberFormat) IsZero() bool {
+	return len(f.ThousandsSeparator) == 0 && f.Decimals <= 0
+}
+
+// formatValue formats numeric values (including wrapped ones)
+// according to given format; other values are returned as is
+func formatValue(val Value, f NumberFormat) string {
+	if f.IsZero() {
+		return val.String()
+	}
+
+	switch typedVal := val.(type) {
+	case ValueInt:
+		return f.formatDigits(strconv.Itoa(typedVal.I))
+	case ValueInt64:
+		return f.formatDigits(strconv.FormatInt(typedVal.I, 10))
+	case ValueUint64:
+		return f.formatDigits(strconv.FormatUint(typedVal.I, 10))
+	case ValueFloat:
+		if f.Decimals > 0 {
+			return f.groupDigits(strconv.FormatFloat(typedVal.F, 'f', f.Decimals, 64))
+		}
+		return f.groupDigits(typedVal.String())
+	case ValueFmt:
+		return formatValue(typedVal.V, f)
+	case ValueSuffix:
+		str := formatValue(typedVal.V, f)
+		if len(str) > 0 {
+			return str + typedVal.Suffix
+		}
+		return ""
+	default:
+		return val.String()
+	}
+}
+
+// formatDigits formats integer given as a string
+func (f NumberFormat) formatDigits(digits string) string {
+	str := f.groupDigits(digits)
+	if f.Decimals > 0 {
+		str += "." + strings.Repeat("0", f.Decimals)
+	}
+	return str
+}
+
+// groupDigits inserts thousands separator into integer part of a number
+func (f NumberFormat) groupDigits(str string) string {
+	if len(f.ThousandsSeparator) == 0 {
+		return str
+	}
+
+	var sign, frac string
+
+	if strings.HasPrefix(str, "-") {
+		sign = "-"
+		str = str[1:]
+	}
+	if idx := strings.Index(str, "."); idx >= 0 {
+		frac = str[idx:]
+		str = str[:idx]
+	}
+
+	var groups []string
+	for len(str) > 3 {
+		groups = append([]string{str[len(str)-3:]}, groups...)
+		str = str[:len(str)-3]
+	}
+	groups = append([]string{str}, groups...)
+
+	return sign + strings.Join(groups, f.ThousandsSeparator) + frac
+}
+
+func isNumericValue(val Value) bool {
+	// Avoid unwrapping common values since it allocates
+	switch typedVal := val.(type) {
+	case ValueString, ValueStrings:
+		return false
+	case ValueFmt:
+		return isNumericValue(typedVal.V)
+	case ValueSuffix:
+		return isNumericValue(typedVal.V)
+	}
+
+	switch val.Value().(type) {
+	case ValueInt, ValueInt64, ValueUint64, ValueFloat, ValuePercent, ValueBytes, ValueDuration:
+		return true
+	default:
+		return false
+	}
+}
+
+func resolveAlignment(header Header, val Value) Alignment {
+	if header.Alignment != AlignDefault {
+		return header.Alignment
+	}
+	if isNumericValue(val) {
+		return AlignRight
+	}
+	return AlignLeft
+}
+
+// alignPadding splits padding around a value of given alignment
+func alignPadding(align Alignment, paddingSize int) (int, int) {
+	// Values may be wider than fixed column widths when streaming
+	if paddingSize < 0 {
+		paddingSize = 0
+	}
+
+	switch align {
+	case AlignRight:
+		return paddingSize, 0
+	case AlignCenter:
+		return paddingSize / 2, paddingSize - paddingSize/2
+	default:
+		return 0, paddingSize
+	}
+}
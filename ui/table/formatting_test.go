@@ -0,0 +1,118 @@
+package table_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatting(t *testing.T) {
+	t.Run("right aligns numeric columns by default", func(t *testing.T) {
+		table := Table{
+			Header: []Header{NewHeader("Name"), NewHeader("Count")},
+			Rows: [][]Value{
+				{ValueString{S: "a"}, ValueInt{I: 5}},
+				{ValueString{S: "bbbbbb"}, ValueInt{I: 1234}},
+			},
+			BorderStr: "|",
+		}
+
+		buf := bytes.NewBufferString("")
+		assert.Equal(t, table.Print(buf), nil)
+		assert.Equal(t, "\n"+buf.String(), `
+Name  |Count|
+a     |    5|
+bbbbbb| 1234|
+`)
+	})
+
+	t.Run("uses explicit column alignment", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				{Key: "name", Title: "Name", Alignment: AlignCenter},
+				{Key: "count", Title: "Count", Alignment: AlignLeft},
+			},
+			Rows: [][]Value{
+				{ValueString{S: "a"}, ValueInt{I: 5}},
+				{ValueString{S: "bbbbbb"}, ValueInt{I: 1234}},
+			},
+			BorderStr: "|",
+		}
+
+		buf := bytes.NewBufferString("")
+		assert.Equal(t, table.Print(buf), nil)
+		assert.Equal(t, "\n"+buf.String(), `
+ Name |Count|
+  a   |5|
+bbbbbb|1234|
+`)
+	})
+
+	t.Run("formats numbers with thousands separator and decimals", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				{Key: "size", Title: "Size", NumberFormat: NumberFormat{ThousandsSeparator: ","}},
+				{Key: "cost", Title: "Cost", NumberFormat: NumberFormat{ThousandsSeparator: ",", Decimals: 2}},
+			},
+			Rows: [][]Value{
+				{ValueInt{I: 1234567}, ValueSuffix{V: ValueInt{I: -1000}, Suffix: "$"}},
+				{ValueInt{I: 12}, ValueFmt{V: ValueInt{I: 5}}},
+			},
+			BorderStr: "|",
+		}
+
+		buf := bytes.NewBufferString("")
+		assert.Equal(t, table.Print(buf), nil)
+		assert.Equal(t, "\n"+buf.String(), `
+Size     |Cost|
+1,234,567|-1,000.00$|
+       12|      5.00|
+`)
+	})
+
+	t.Run("keeps column formatting when transposed", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				NewHeader("Name"),
+				{Key: "count", Title: "Count", NumberFormat: NumberFormat{ThousandsSeparator: ","}},
+			},
+			Rows: [][]Value{
+				{ValueString{S: "long-name"}, ValueInt{I: 1234}},
+			},
+			BorderStr: "|",
+			Transpose: true,
+		}
+
+		buf := bytes.NewBufferString("")
+		assert.Equal(t, table.Print(buf), nil)
+		assert.Equal(t, "\n"+buf.String(), `
+Name |long-name|
+Count|    1,234|
+`)
+	})
+
+	t.Run("formats and right aligns rich numeric values", func(t *testing.T) {
+		table := Table{
+			Header: []Header{
+				{Key: "size", Title: "Size"},
+				{Key: "count", Title: "Count", NumberFormat: NumberFormat{ThousandsSeparator: ","}},
+				{Key: "ratio", Title: "Ratio", NumberFormat: NumberFormat{ThousandsSeparator: ",", Decimals: 1}},
+			},
+			Rows: [][]Value{
+				{ValueBytes{B: 1536}, ValueUint64{I: 1234567}, ValueFloat{F: 1234.56}},
+				{ValueBytes{B: 10}, ValueInt64{I: -5}, ValueFloat{F: 2}},
+			},
+			BorderStr: "|",
+		}
+
+		buf := bytes.NewBufferString("")
+		assert.Equal(t, table.Print(buf), nil)
+		assert.Equal(t, "\n"+buf.String(), `
+Size   |Count    |Ratio|
+1.5 KiB|1,234,567|1,234.6|
+   10 B|       -5|    2.0|
+`)
+	})
+}
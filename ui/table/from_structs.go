@@ -0,0 +1,242 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var (
+	valueType    = reflect.TypeOf((*Value)(nil)).Elem()
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+type structColumn struct {
+	Header   Header
+	FieldIdx int
+	Method   string
+	Sort     string
+}
+
+// FromStructs builds a table from a slice of structs (or struct pointers).
+// Columns are configured via `table` struct field tags (see StructTag);
+// untagged fields are ignored. Computed columns are specified via
+// blank fields with method option (e.g. `table:"Age,method=Age"`);
+// method should return a value and optionally an error.
+func FromStructs(items interface{}) (Table, error) {
+	itemsVal := reflect.ValueOf(items)
+
+	if itemsVal.Kind() != reflect.Slice {
+		return Table{}, fmt.Errorf("Expected a slice of structs, but was '%T'", items)
+	}
+
+	structType := itemsVal.Type().Elem()
+
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return Table{}, fmt.Errorf("Expected a slice of structs, but was '%T'", items)
+	}
+
+	var table Table
+
+	cols, err := structColumns(structType)
+	if err != nil {
+		return Table{}, err
+	}
+
+	for i, col := range cols {
+		table.Header = append(table.Header, col.Header)
+
+		if len(col.Method) > 0 {
+			_, found := reflect.PtrTo(structType).MethodByName(col.Method)
+			if !found {
+				return Table{}, fmt.Errorf("Expected method '%s' for column '%s' on '%s'",
+					col.Method, col.Header.Key, structType)
+			}
+		}
+
+		if len(col.Sort) > 0 {
+			table.SortBy = append(table.SortBy, ColumnSort{Column: i, Asc: col.Sort == "asc"})
+		}
+	}
+
+	for i := 0; i < itemsVal.Len(); i++ {
+		itemVal := itemsVal.Index(i)
+
+		if itemVal.Kind() == reflect.Ptr {
+			if itemVal.IsNil() {
+				continue
+			}
+			itemVal = itemVal.Elem()
+		}
+
+		// Copy so that methods with pointer receivers can be called
+		itemPtr := reflect.New(structType)
+		itemPtr.Elem().Set(itemVal)
+
+		var row []Value
+
+		for _, col := range cols {
+			if len(col.Method) > 0 {
+				row = append(row, methodValue(itemPtr.MethodByName(col.Method)))
+			} else {
+				row = append(row, valueFromReflect(itemPtr.Elem().Field(col.FieldIdx)))
+			}
+		}
+
+		table.Rows = append(table.Rows, row)
+	}
+
+	return table, nil
+}
+
+func structColumns(structType reflect.Type) ([]structColumn, error) {
+	var cols []structColumn
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		tagVal, found := field.Tag.Lookup(StructTagName)
+		if !found {
+			continue
+		}
+
+		tag, err := ParseStructTag(tagVal)
+		if err != nil {
+			return nil, fmt.Errorf("Parsing tag of field '%s': %s", field.Name, err)
+		}
+
+		if tag.Skip {
+			continue
+		}
+
+		// Only computed columns may be specified on unexported fields
+		if len(field.PkgPath) > 0 && len(tag.Method) == 0 {
+			return nil, fmt.Errorf("Expected field '%s' to be exported or specify method", field.Name)
+		}
+
+		cols = append(cols, structColumn{
+			Header: Header{
+				Key:    tag.Key,
+				Title:  tag.Title,
+				Hidden: tag.Hidden,
+				Wide:   tag.Wide,
+			},
+			FieldIdx: i,
+			Method:   tag.Method,
+			Sort:     tag.Sort,
+		})
+	}
+
+	return cols, nil
+}
+
+func methodValue(method reflect.Value) Value {
+	methodType := method.Type()
+
+	switch {
+	case methodType.NumIn() != 0:
+		return ValueError{E: fmt.Errorf("Expected method to not take arguments")}
+
+	case methodType.NumOut() == 1:
+		return valueFromReflect(method.Call(nil)[0])
+
+	case methodType.NumOut() == 2 && methodType.Out(1) == errorType:
+		results := method.Call(nil)
+		if !results[1].IsNil() {
+			return ValueError{E: results[1].Interface().(error)}
+		}
+		return valueFromReflect(results[0])
+
+	default:
+		return ValueError{E: fmt.Errorf("Expected method to return a value and optionally an error")}
+	}
+}
+
+// valueFromReflect chooses value type based on Go type
+func valueFromReflect(val reflect.Value) Value {
+	if !val.IsValid() {
+		return ValueNone{}
+	}
+
+	typ := val.Type()
+
+	switch {
+	case typ.Implements(valueType):
+		if (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) && val.IsNil() {
+			return ValueNone{}
+		}
+		return val.Interface().(Value)
+
+	case typ == timeType:
+		return ValueTime{T: val.Interface().(time.Time)}
+
+	case typ == durationType:
+		return ValueDuration{D: time.Duration(val.Int())}
+
+	case typ.Implements(errorType):
+		if (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) && val.IsNil() {
+			return ValueNone{}
+		}
+		return ValueError{E: val.Interface().(error)}
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if val.IsNil() {
+			return ValueNone{}
+		}
+		if typ.Implements(stringerType) && val.Kind() == reflect.Ptr {
+			return ValueString{S: val.Interface().(fmt.Stringer).String()}
+		}
+		return valueFromReflect(val.Elem())
+	}
+
+	if typ.Implements(stringerType) {
+		return ValueString{S: val.Interface().(fmt.Stringer).String()}
+	}
+
+	// Fields of copied items are addressable (e.g. for String with pointer receiver)
+	if val.CanAddr() && val.Addr().Type().Implements(stringerType) {
+		return ValueString{S: val.Addr().Interface().(fmt.Stringer).String()}
+	}
+
+	switch val.Kind() {
+	case reflect.String:
+		return ValueString{S: val.String()}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return ValueInt{I: int(val.Int())}
+
+	case reflect.Int64:
+		return ValueInt64{I: val.Int()}
+
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return ValueInt{I: int(val.Uint())}
+
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return ValueUint64{I: val.Uint()}
+
+	case reflect.Float32, reflect.Float64:
+		return ValueFloat{F: val.Float()}
+
+	case reflect.Bool:
+		return ValueBool{B: val.Bool()}
+
+	case reflect.Slice:
+		if typ.Elem().Kind() == reflect.String {
+			strs := make([]string, val.Len())
+			for i := range strs {
+				strs[i] = val.Index(i).String()
+			}
+			return ValueStrings{S: strs}
+		}
+	}
+
+	return ValueInterface{I: val.Interface()}
+}
@@ -0,0 +1,163 @@
+package table_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+type structState int
+
+func (s structState) String() string { return fmt.Sprintf("state-%d", int(s)) }
+
+type structPtrState struct{ ID int }
+
+func (s *structPtrState) String() string { return fmt.Sprintf("ptr-state-%d", s.ID) }
+
+type structItem struct {
+	Name     string            `table:"Name,sort=asc"`
+	Count    uint              `table:"Count,sort=desc"`
+	Ready    bool              `table:"Ready"`
+	Created  time.Time         `table:"Created,wide"`
+	Tags     []string          `table:"Tags"`
+	State    structState       `table:"State"`
+	Labels   map[string]string `table:"Labels,hidden"`
+	Err      error             `table:"Error"`
+	Parent   *string           `table:"Parent"`
+	Custom   Value             `table:",key=custom"`
+	Internal string
+	Skipped  string `table:"-"`
+
+	_ struct{} `table:"Summary,method=Summary"`
+	_ struct{} `table:"Checked,method=Check"`
+}
+
+func (i *structItem) Summary() string { return i.Name + "!" }
+
+func (i structItem) Check() (bool, error) {
+	if i.Count == 0 {
+		return false, errors.New("no count")
+	}
+	return true, nil
+}
+
+func TestFromStructs(t *testing.T) {
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	parent := "parent"
+
+	t.Run("builds headers, sorting and rows from struct fields", func(t *testing.T) {
+		table, err := FromStructs([]structItem{
+			{
+				Name: "a", Count: 3, Ready: true, Created: created, Tags: []string{"t1"}, State: 2,
+				Labels: map[string]string{"k": "v"}, Err: errors.New("err"), Parent: &parent,
+				Custom: ValueSuffix{V: ValueInt{I: 1}, Suffix: "s"},
+			},
+			{Name: "b"},
+		})
+		assert.Equal(t, err, nil)
+
+		assert.Equal(t, table.Header, []Header{
+			{Key: "name", Title: "Name"},
+			{Key: "count", Title: "Count"},
+			{Key: "ready", Title: "Ready"},
+			{Key: "created", Title: "Created", Wide: true},
+			{Key: "tags", Title: "Tags"},
+			{Key: "state", Title: "State"},
+			{Key: "labels", Title: "Labels", Hidden: true},
+			{Key: "error", Title: "Error"},
+			{Key: "parent", Title: "Parent"},
+			{Key: "custom"},
+			{Key: "summary", Title: "Summary"},
+			{Key: "checked", Title: "Checked"},
+		})
+
+		assert.Equal(t, table.SortBy, []ColumnSort{{Column: 0, Asc: true}, {Column: 1, Asc: false}})
+
+		assert.Equal(t, table.Rows, [][]Value{
+			{
+				ValueString{S: "a"}, ValueUint64{I: 3}, ValueBool{B: true}, ValueTime{T: created},
+				ValueStrings{S: []string{"t1"}}, ValueString{S: "state-2"},
+				ValueInterface{I: map[string]string{"k": "v"}}, ValueError{E: errors.New("err")},
+				ValueString{S: "parent"}, ValueSuffix{V: ValueInt{I: 1}, Suffix: "s"},
+				ValueString{S: "a!"}, ValueBool{B: true},
+			},
+			{
+				ValueString{S: "b"}, ValueUint64{I: 0}, ValueBool{B: false}, ValueTime{},
+				ValueStrings{S: []string{}}, ValueString{S: "state-0"},
+				ValueInterface{I: map[string]string(nil)}, ValueNone{},
+				ValueNone{}, ValueNone{},
+				ValueString{S: "b!"}, ValueError{E: errors.New("no count")},
+			},
+		})
+	})
+
+	t.Run("chooses numeric values by field type", func(t *testing.T) {
+		type item struct {
+			Int      int           `table:"Int"`
+			Int64    int64         `table:"Int64"`
+			Uint8    uint8         `table:"Uint8"`
+			Float    float32       `table:"Float"`
+			Duration time.Duration `table:"Duration"`
+		}
+
+		table, err := FromStructs([]item{{1, 2, 3, 4.5, time.Minute}})
+		assert.Equal(t, err, nil)
+		assert.Equal(t, table.Rows, [][]Value{{
+			ValueInt{I: 1}, ValueInt64{I: 2}, ValueInt{I: 3}, ValueFloat{F: 4.5}, ValueDuration{D: time.Minute},
+		}})
+	})
+
+	t.Run("uses String methods with pointer receivers", func(t *testing.T) {
+		type item struct {
+			State    structPtrState  `table:"State"`
+			StatePtr *structPtrState `table:"State Ptr"`
+		}
+
+		table, err := FromStructs([]item{{structPtrState{1}, &structPtrState{2}}})
+		assert.Equal(t, err, nil)
+		assert.Equal(t, table.Rows, [][]Value{{ValueString{S: "ptr-state-1"}, ValueString{S: "ptr-state-2"}}})
+	})
+
+	t.Run("accepts slices of struct pointers", func(t *testing.T) {
+		table, err := FromStructs([]*structItem{{Name: "a"}, nil})
+		assert.Equal(t, err, nil)
+		assert.Equal(t, len(table.Rows), 1)
+		assert.Equal(t, table.Rows[0][0], ValueString{S: "a"})
+	})
+
+	t.Run("returns error if not given a slice of structs", func(t *testing.T) {
+		_, err := FromStructs(structItem{})
+		assert.Equal(t, err.Error(), "Expected a slice of structs, but was 'table_test.structItem'")
+
+		_, err = FromStructs([]string{})
+		assert.Equal(t, err.Error(), "Expected a slice of structs, but was '[]string'")
+	})
+
+	t.Run("returns error for invalid tags", func(t *testing.T) {
+		type item struct {
+			Name string `table:"Name,sort=up"`
+		}
+		_, err := FromStructs([]item{})
+		assert.Equal(t, err.Error(), "Parsing tag of field 'Name': Expected struct tag option 'sort' to be 'asc' or 'desc'")
+	})
+
+	t.Run("returns error if method is not found", func(t *testing.T) {
+		type item struct {
+			_ struct{} `table:"Age,method=Age"`
+		}
+		_, err := FromStructs([]item{})
+		assert.Equal(t, err.Error(), "Expected method 'Age' for column 'age' on 'table_test.item'")
+	})
+
+	t.Run("returns error for unexported fields without method", func(t *testing.T) {
+		type item struct {
+			name string `table:"Name"`
+		}
+		_, err := FromStructs([]item{{name: "a"}})
+		assert.Equal(t, err.Error(), "Expected field 'name' to be exported or specify method")
+	})
+}
@@ -0,0 +1,164 @@
+package table
+
+import (
+	"sort"
+	"strings"
+)
+
+type rowGroup struct {
+	Title     string
+	Rows      [][]Value
+	Subtotals [][]Value
+}
+
+func (t Table) groupColumns() ([]int, error) {
+	var cols []int
+
+	for _, key := range t.GroupBy.Keys {
+		idx := t.headerIndex(key)
+		if idx < 0 {
+			return nil, UnknownColumnError{Column: key, ValidKeys: t.headerKeys()}
+		}
+		cols = append(cols, idx)
+	}
+
+	return cols, nil
+}
+
+// groupSortBy makes sure that grouped rows are next to each other
+// while keeping requested sorting within each group
+func (t Table) groupSortBy(groupCols []int) []ColumnSort {
+	var sortBy []ColumnSort
+	for _, col := range groupCols {
+		sortBy = append(sortBy, ColumnSort{Column: col, Asc: true})
+	}
+	return append(sortBy, t.SortBy...)
+}
+
+func (t Table) sortedRows() [][]Value {
+	rows := t.collectRows()
+	// Unknown group columns are reported by Print
+	groupCols, _ := t.groupColumns()
+	if sortBy := t.groupSortBy(groupCols); len(sortBy) > 0 {
+		sort.Sort(Sorting{sortBy, rows})
+	}
+	return rows
+}
+
+func (t Table) dedupedColumns() []int {
+	groupCols, _ := t.groupColumns()
+	if len(groupCols) > 0 {
+		return groupCols
+	}
+	return []int{0}
+}
+
+func (t Table) splitGroups(rows [][]Value, groupCols []int) []rowGroup {
+	var groups []rowGroup
+	var lastKey string
+
+	for i, row := range rows {
+		key := groupKey(row, groupCols)
+
+		if i == 0 || key != lastKey {
+			group := rowGroup{}
+
+			if t.GroupBy.TitleFunc != nil {
+				var vals []Value
+				for _, col := range groupCols {
+					vals = append(vals, row[col])
+				}
+				group.Title = t.GroupBy.TitleFunc(vals)
+			}
+
+			groups = append(groups, group)
+			lastKey = key
+		}
+
+		groups[len(groups)-1].Rows = append(groups[len(groups)-1].Rows, row)
+	}
+
+	// Subtotals of unknown columns are reported by Print
+	for i, group := range groups {
+		groups[i].Subtotals, _ = aggregateRows(t.GroupBy.Subtotals, group.Rows, len(t.Header))
+	}
+
+	return groups
+}
+
+func groupKey(row []Value, cols []int) string {
+	var vals []string
+	for _, col := range cols {
+		vals = append(vals, row[col].String())
+	}
+	return strings.Join(vals, "\x00")
+}
+
+// dedupColumns replaces repeated values in specified columns. Values
+// in later columns are only considered repeated when values in earlier
+// columns are repeated as well (e.g. same region in a different env).
+func (t Table) dedupColumns(rows [][]Value, cols []int) {
+	dupVal := t.duplicateValue()
+
+	// Buffers are swapped for each row to avoid allocations
+	lastVals := make([]string, len(cols))
+	currVals := make([]string, len(cols))
+
+	for rowIdx, row := range rows {
+		for i, col := range cols {
+			currVals[i] = row[col].String()
+		}
+
+		if rowIdx > 0 {
+			for i, col := range cols {
+				if currVals[i] != lastVals[i] {
+					break
+				}
+				row[col] = dupVal
+			}
+		}
+
+		lastVals, currVals = currVals, lastVals
+	}
+}
+
+func (t Table) duplicateValue() Value {
+	if len(t.DuplicateStr) > 0 {
+		return ValueString{t.DuplicateStr}
+	}
+	return ValueString{"^"}
+}
+
+func (t Table) writeGroups(writer *Writer, groups []rowGroup) {
+	separateGroups := t.GroupBy.TitleFunc != nil || len(t.GroupBy.Subtotals) > 0
+
+	for i, group := range groups {
+		if i > 0 && separateGroups {
+			writer.Write(t.Header, emptyRow(len(t.Header)))
+		}
+
+		if len(group.Title) > 0 {
+			writer.WriteText(group.Title)
+		}
+
+		for _, row := range group.Rows {
+			writer.Write(t.Header, row)
+		}
+
+		if len(group.Subtotals) > 0 {
+			writer.WriteRule()
+
+			for _, row := range group.Subtotals {
+				writer.Write(t.Header, row)
+			}
+		}
+	}
+}
+
+func emptyRow(num int) []Value {
+	row := make([]Value, num)
+	for i := range row {
+		row[i] = EmptyValue{}
+	}
+	return row
+}
@@ -0,0 +1,218 @@
+package table_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableGroupBy(t *testing.T) {
+	t.Run("groups rows and dedups grouped columns", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Content: "instances",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Env"),
+				NewHeader("Region"),
+				NewHeader("Disk"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "i4"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 4}},
+				{ValueString{S: "i1"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 1}},
+				{ValueString{S: "i3"}, ValueString{S: "prod"}, ValueString{S: "eu"}, ValueInt{I: 3}},
+				{ValueString{S: "i2"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 2}},
+				{ValueString{S: "i5"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 5}},
+			},
+			BorderStr: "|",
+			GroupBy:   GroupBy{Keys: []string{"env", "region"}},
+		}
+		table.Print(buf)
+		assert.Equal(t, "\n"+buf.String(), `
+Name|Env |Region|Disk|
+i1  |dev |us    |   1|
+i2  |^   |^     |   2|
+i3  |prod|eu    |   3|
+i4  |^   |us    |   4|
+i5  |^   |^     |   5|
+
+5 instances
+`)
+	})
+
+	t.Run("prints group titles and subtotals", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Content: "instances",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Env"),
+				NewHeader("Region"),
+				NewHeader("Disk"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "i4"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 4}},
+				{ValueString{S: "i1"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 1}},
+				{ValueString{S: "i3"}, ValueString{S: "prod"}, ValueString{S: "eu"}, ValueInt{I: 3}},
+				{ValueString{S: "i2"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 2}},
+				{ValueString{S: "i5"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 5}},
+			},
+			BorderStr: "|",
+		}
+		table.GroupBy = GroupBy{
+			Keys: []string{"env"},
+			TitleFunc: func(vals []Value) string {
+				return fmt.Sprintf("Environment %s", strings.ToUpper(vals[0].String()))
+			},
+			Subtotals: []FooterRow{{
+				Aggregates: []ColumnAggregate{
+					{Column: 0, Func: AggregateLabel("Subtotal")},
+					{Column: 3, Func: AggregateSum},
+				},
+			}},
+		}
+		table.Print(buf)
+		assert.Equal(t, "\n"+buf.String(), `
+Name    |Env |Region|Disk|
+Environment DEV
+i1      |dev |us    |   1|
+i2      |^   |us    |   2|
+--------|----|------|----|
+Subtotal|    |      |   3|
+
+Environment PROD
+i3      |prod|eu    |   3|
+i4      |^   |us    |   4|
+i5      |^   |us    |   5|
+--------|----|------|----|
+Subtotal|    |      |  12|
+
+5 instances
+`)
+	})
+
+	t.Run("keeps subtotals under their columns when columns are selected", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Header: []Header{NewHeader("Name"), NewHeader("Env"), NewHeader("Disk")},
+			Rows: [][]Value{
+				{ValueString{S: "i1"}, ValueString{S: "dev"}, ValueInt{I: 1}},
+				{ValueString{S: "i2"}, ValueString{S: "dev"}, ValueInt{I: 2}},
+			},
+			GroupBy: GroupBy{
+				Keys: []string{"env"},
+				Subtotals: []FooterRow{{
+					Aggregates: []ColumnAggregate{
+						{Column: 0, Func: AggregateLabel("Subtotal")},
+						{Column: 2, Func: AggregateSum},
+					},
+				}},
+			},
+			BorderStr: "|",
+		}
+
+		err := table.SelectColumns([]string{"disk", "env", "name"})
+		assert.NoError(t, err)
+
+		table.Print(buf)
+		assert.Equal(t, "\n"+buf.String(), `
+Disk|Env|Name|
+   1|dev|i1|
+   2|^  |i2|
+----|---|--------|
+   3|   |Subtotal|
+`)
+	})
+
+	t.Run("returns grouped rows without dedup when first column is filled", func(t *testing.T) {
+		table := Table{
+			Content: "instances",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Env"),
+				NewHeader("Region"),
+				NewHeader("Disk"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "i4"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 4}},
+				{ValueString{S: "i1"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 1}},
+				{ValueString{S: "i3"}, ValueString{S: "prod"}, ValueString{S: "eu"}, ValueInt{I: 3}},
+				{ValueString{S: "i2"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 2}},
+				{ValueString{S: "i5"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 5}},
+			},
+			BorderStr:       "|",
+			GroupBy:         GroupBy{Keys: []string{"env"}},
+			FillFirstColumn: true,
+		}
+
+		var names []string
+		for _, row := range table.AsRows() {
+			names = append(names, row[0].String()+"/"+row[1].String())
+		}
+		assert.Equal(t, names, []string{"i1/dev", "i2/dev", "i3/prod", "i4/prod", "i5/prod"})
+	})
+
+	t.Run("returns error when grouping by unknown column", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Content: "instances",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Env"),
+				NewHeader("Region"),
+				NewHeader("Disk"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "i4"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 4}},
+				{ValueString{S: "i1"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 1}},
+				{ValueString{S: "i3"}, ValueString{S: "prod"}, ValueString{S: "eu"}, ValueInt{I: 3}},
+				{ValueString{S: "i2"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 2}},
+				{ValueString{S: "i5"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 5}},
+			},
+			BorderStr: "|",
+			GroupBy:   GroupBy{Keys: []string{"zone"}},
+		}
+
+		err := table.Print(buf)
+		assert.EqualError(t, err, "Grouping rows: Unknown column 'zone' (valid columns: name, env, region, disk)")
+		assert.Equal(t, buf.String(), "")
+	})
+
+	t.Run("returns error when subtotal aggregates unknown column", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Content: "instances",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Env"),
+				NewHeader("Region"),
+				NewHeader("Disk"),
+			},
+			SortBy: []ColumnSort{{Column: 0, Asc: true}},
+			Rows: [][]Value{
+				{ValueString{S: "i4"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 4}},
+				{ValueString{S: "i1"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 1}},
+				{ValueString{S: "i3"}, ValueString{S: "prod"}, ValueString{S: "eu"}, ValueInt{I: 3}},
+				{ValueString{S: "i2"}, ValueString{S: "dev"}, ValueString{S: "us"}, ValueInt{I: 2}},
+				{ValueString{S: "i5"}, ValueString{S: "prod"}, ValueString{S: "us"}, ValueInt{I: 5}},
+			},
+			BorderStr: "|",
+		}
+		table.GroupBy = GroupBy{
+			Keys:      []string{"env"},
+			Subtotals: []FooterRow{{Aggregates: []ColumnAggregate{{Column: 4, Func: AggregateSum}}}},
+		}
+
+		err := table.Print(buf)
+		assert.EqualError(t, err, "Grouping rows: Expected aggregated column 4 to be less than number of columns (4)")
+		assert.Equal(t, buf.String(), "")
+	})
+}
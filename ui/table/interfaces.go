@@ -12,10 +12,16 @@ type Table struct {
 
 	SortBy []ColumnSort
 
+	// GroupBy groups rows by values of specified columns
+	GroupBy GroupBy
+
 	// Either sections or rows should be provided
 	Sections []Section
 	Rows     [][]Value
 
+	// Footer rows are printed after all sorted rows
+	Footer []FooterRow
+
 	Notes []string
 
 	// Formatting
@@ -25,13 +31,81 @@ type Table struct {
 	DuplicateStr     string
 	BackgroundStr    string
 	BorderStr        string
+	BorderStyle      BorderStyle
+	LinkStyle        LinkStyle
 	Transpose        bool
+
+	// Wide columns are only shown when enabled
+	ShowWideColumns bool
 }
 
 type Header struct {
 	Key    string
 	Title  string
 	Hidden bool
+
+	// Wide marks column as hidden unless table shows wide columns
+	Wide bool
+
+	// Alignment defaults to right for numbers and left otherwise
+	Alignment    Alignment
+	NumberFormat NumberFormat
+}
+
+type Alignment int
+
+const (
+	AlignDefault Alignment = iota
+	AlignLeft
+	AlignRight
+	AlignCenter
+)
+
+type NumberFormat struct {
+	// ThousandsSeparator is inserted between groups of digits (e.g. ",")
+	ThousandsSeparator string
+	// Decimals is a number of fixed decimal places (if > 0)
+	Decimals int
+}
+
+// CustomColumn selects a column by its header key
+// and optionally overrides its title (e.g. NAME:name)
+type CustomColumn struct {
+	Title string
+	Key   string
+}
+
+// StructTag describes a column configured via struct field tag
+// (e.g. `table:"Title,key=name,sort=asc,wide,hidden,method=Fn"`);
+// key defaults to keyified title
+type StructTag struct {
+	Title  string
+	Key    string
+	Wide   bool
+	Hidden bool
+
+	// Sort is either empty, "asc" or "desc"
+	Sort string
+
+	// Method is a name of a method used to compute column value
+	Method string
+
+	// Skip is set for fields tagged with `table:"-"`
+	Skip bool
+}
+
+// RowIterator returns next row or false when there are no more rows
+type RowIterator func() ([]Value, bool)
+
+type StreamOpts struct {
+	// SampleSize is a number of first rows used to determine
+	// column widths before any rows are written (defaults to 100);
+	// following rows are written in batches of the same size
+	SampleSize int
+
+	// MinWidths specifies column widths by header key
+	// (useful when first rows are not representative)
+	MinWidths map[string]int
 }
 
 type Section struct {
@@ -39,6 +113,29 @@ type Section struct {
 	Rows        [][]Value
 }
 
+type FooterRow struct {
+	Aggregates []ColumnAggregate
+}
+
+type ColumnAggregate struct {
+	Column int
+	Func   AggregateFunc
+}
+
+// AggregateFunc receives all values in a column (excluding footer)
+type AggregateFunc func([]Value) Value
+
+type GroupBy struct {
+	// Header keys of columns to group by (in order of significance)
+	Keys []string
+
+	// TitleFunc receives group's values for grouped columns
+	TitleFunc func([]Value) string
+
+	// Subtotals are printed after each group
+	Subtotals []FooterRow
+}
+
 type ColumnSort struct {
 	Column int
 	Asc    bool
@@ -50,6 +147,12 @@ type Value interface {
 	Compare(Value) int
 }
 
+// JSONValue is implemented by values that have native JSON representation
+// (used by typed JSON output instead of String())
+type JSONValue interface {
+	JSONValue() interface{}
+}
+
 type ValueString struct {
 	S string
 }
@@ -64,10 +167,45 @@ type ValueInt struct {
 	I int
 }
 
+type ValueInt64 struct {
+	I int64
+}
+
+type ValueUint64 struct {
+	I uint64
+}
+
+type ValueFloat struct {
+	F float64
+	// Precision is a number of decimal places (shortest representation if 0)
+	Precision int
+}
+
+// ValuePercent shows a fraction (e.g. 0.5) as a percentage (e.g. 50%)
+type ValuePercent struct {
+	F float64
+}
+
+// ValueBytes shows a number of bytes in binary units (e.g. 1.2 GiB)
+type ValueBytes struct {
+	B int64
+}
+
+type ValueDuration struct {
+	D time.Duration
+}
+
 type ValueTime struct {
 	T time.Time
 }
 
+// ValueRelativeTime shows time relative to now (e.g. 5m ago)
+type ValueRelativeTime struct {
+	T time.Time
+	// Clock returns current time (defaults to time.Now)
+	Clock func() time.Time
+}
+
 type ValueBool struct {
 	B bool
 }
@@ -82,6 +220,21 @@ type ValueError struct {
 
 type ValueNone struct{}
 
+// ValueLink shows text pointing to URL (rendered based on table's LinkStyle)
+type ValueLink struct {
+	Text string
+	URL  string
+}
+
+// ValueStatus shows text with severity level (e.g. ok, warning)
+// that is used for coloring and optional symbol (e.g. ✓)
+type ValueStatus struct {
+	Level  StatusLevel
+	Text   string
+	Symbol bool
+	Func   func(string, ...interface{}) string
+}
+
 type ValueFmt struct {
 	V     Value
 	Error bool
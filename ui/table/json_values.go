@@ -0,0 +1,87 @@
+package table
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+)
+
+// JSONValueOf returns native JSON representation of a value
+// falling back to its string representation
+func JSONValueOf(val Value) interface{} {
+	if val == nil {
+		return nil
+	}
+	if jsonVal, ok := val.(JSONValue); ok {
+		return jsonVal.JSONValue()
+	}
+	return val.String()
+}
+
+func (t ValueString) JSONValue() interface{}   { return t.S }
+func (t EmptyValue) JSONValue() interface{}    { return nil }
+func (t ValueInt) JSONValue() interface{}      { return t.I }
+func (t ValueInt64) JSONValue() interface{}    { return t.I }
+func (t ValueUint64) JSONValue() interface{}   { return t.I }
+func (t ValueFloat) JSONValue() interface{}    { return jsonFloat(t.F) }
+func (t ValuePercent) JSONValue() interface{}  { return jsonFloat(t.F) }
+func (t ValueBytes) JSONValue() interface{}    { return t.B }
+func (t ValueDuration) JSONValue() interface{} { return int64(t.D) }
+func (t ValueBool) JSONValue() interface{}     { return t.B }
+func (t ValueNone) JSONValue() interface{}     { return nil }
+func (t ValueFmt) JSONValue() interface{}      { return JSONValueOf(t.V) }
+func (t ValueSuffix) JSONValue() interface{}   { return t.String() }
+
+func (t ValueStrings) JSONValue() interface{} {
+	if t.S == nil {
+		return []string{}
+	}
+	return t.S
+}
+
+func (t ValueTime) JSONValue() interface{} {
+	if t.T.IsZero() {
+		return nil
+	}
+	return t.T.Format(time.RFC3339)
+}
+
+func (t ValueRelativeTime) JSONValue() interface{} {
+	return ValueTime{T: t.T}.JSONValue()
+}
+
+func (t ValueError) JSONValue() interface{} {
+	if t.E != nil {
+		return t.E.Error()
+	}
+	return nil
+}
+
+func (t ValueInterface) JSONValue() interface{} {
+	val := t.I
+
+	// Same contract as in String()
+	if i, ok := val.(interface{ MarshalYAML() (interface{}, error) }); ok {
+		v, err := i.MarshalYAML()
+		if err != nil {
+			return t.String()
+		}
+		val = v
+	}
+
+	// Fall back to string representation for values
+	// that cannot be serialized (e.g. maps with non-string keys)
+	if _, err := json.Marshal(val); err != nil {
+		return t.String()
+	}
+
+	return val
+}
+
+// jsonFloat returns nil for values that cannot be represented in JSON
+func jsonFloat(f float64) interface{} {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil
+	}
+	return f
+}
@@ -0,0 +1,77 @@
+package table_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+type yamlMarshaler struct{}
+
+func (yamlMarshaler) MarshalYAML() (interface{}, error) { return []string{"a"}, nil }
+
+func TestJSONValueOf(t *testing.T) {
+	t.Run("returns native values", func(t *testing.T) {
+		assert.Equal(t, JSONValueOf(ValueString{S: "s"}), "s")
+		assert.Equal(t, JSONValueOf(ValueInt{I: 3}), 3)
+		assert.Equal(t, JSONValueOf(ValueBool{B: false}), false)
+		assert.Equal(t, JSONValueOf(ValueStrings{S: []string{"a"}}), []string{"a"})
+		assert.Equal(t, JSONValueOf(ValueStrings{}), []string{})
+		assert.Equal(t, JSONValueOf(ValueError{E: errors.New("err")}), "err")
+		assert.Equal(t, JSONValueOf(ValueSuffix{V: ValueInt{I: 3}, Suffix: "s"}), "3s")
+	})
+
+	t.Run("returns raw quantities for rich values", func(t *testing.T) {
+		assert.Equal(t, JSONValueOf(ValueInt64{I: 3}), int64(3))
+		assert.Equal(t, JSONValueOf(ValueUint64{I: 3}), uint64(3))
+		assert.Equal(t, JSONValueOf(ValueFloat{F: 1.5, Precision: 3}), 1.5)
+		assert.Equal(t, JSONValueOf(ValueFloat{F: math.NaN()}), nil)
+		assert.Equal(t, JSONValueOf(ValuePercent{F: 0.5}), 0.5)
+		assert.Equal(t, JSONValueOf(ValueBytes{B: 2048}), int64(2048))
+		assert.Equal(t, JSONValueOf(ValueDuration{D: time.Second}), int64(1000000000))
+
+		ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		assert.Equal(t, JSONValueOf(ValueRelativeTime{T: ts}), "2020-01-02T03:04:05Z")
+	})
+
+	t.Run("returns RFC 3339 times and null for zero time", func(t *testing.T) {
+		ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		assert.Equal(t, JSONValueOf(ValueTime{T: ts}), "2020-01-02T03:04:05Z")
+		assert.Equal(t, JSONValueOf(ValueTime{}), nil)
+	})
+
+	t.Run("returns null for missing values", func(t *testing.T) {
+		assert.Equal(t, JSONValueOf(nil), nil)
+		assert.Equal(t, JSONValueOf(ValueNone{}), nil)
+		assert.Equal(t, JSONValueOf(EmptyValue{}), nil)
+		assert.Equal(t, JSONValueOf(ValueError{}), nil)
+	})
+
+	t.Run("unwraps formatted values", func(t *testing.T) {
+		assert.Equal(t, JSONValueOf(ValueFmt{V: ValueInt{I: 3}}), 3)
+	})
+
+	t.Run("returns nested values for interfaces", func(t *testing.T) {
+		assert.Equal(t, JSONValueOf(ValueInterface{I: map[string]int{"a": 1}}), map[string]int{"a": 1})
+		assert.Equal(t, JSONValueOf(ValueInterface{I: yamlMarshaler{}}), []string{"a"})
+	})
+
+	t.Run("falls back to string for values that cannot be serialized", func(t *testing.T) {
+		assert.Equal(t, JSONValueOf(ValueInterface{I: map[bool]string{true: "a"}}),
+			`<serialization error> : map[bool]string{true:"a"}`)
+	})
+
+	t.Run("falls back to string for values without native representation", func(t *testing.T) {
+		assert.Equal(t, JSONValueOf(customValue{"custom"}), "custom")
+	})
+}
+
+type customValue struct{ s string }
+
+func (v customValue) String() string          { return v.s }
+func (v customValue) Value() Value            { return v }
+func (v customValue) Compare(other Value) int { return 0 }
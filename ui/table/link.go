@@ -0,0 +1,72 @@
+package table
+
+import (
+	"strings"
+)
+
+// LinkStyle controls how ValueLink is rendered
+type LinkStyle string
+
+const (
+	// LinkStyleDefault is the same as LinkStylePlain
+	LinkStyleDefault LinkStyle = ""
+	// LinkStylePlain shows text followed by URL (e.g. docs (https://...))
+	LinkStylePlain LinkStyle = "plain"
+	// LinkStyleHyperlink shows text as terminal hyperlink (OSC 8)
+	LinkStyleHyperlink LinkStyle = "hyperlink"
+	// LinkStyleURL shows only URL
+	LinkStyleURL LinkStyle = "url"
+)
+
+func NewValueLink(text, url string) ValueLink { return ValueLink{Text: text, URL: url} }
+
+func (t ValueLink) String() string { return t.Format(LinkStylePlain) }
+func (t ValueLink) Value() Value   { return t }
+
+func (t ValueLink) Compare(other Value) int {
+	otherLink := other.(ValueLink)
+	if c := (ValueString{S: t.Text}).Compare(ValueString{S: otherLink.Text}); c != 0 {
+		return c
+	}
+	return ValueString{S: t.URL}.Compare(ValueString{S: otherLink.URL})
+}
+
+func (t ValueLink) JSONValue() interface{} {
+	return map[string]interface{}{"Text": t.Text, "URL": t.URL}
+}
+
+// Format renders link in given style
+func (t ValueLink) Format(style LinkStyle) string {
+	text := t.Text
+	if len(text) == 0 {
+		text = t.URL
+	}
+
+	switch {
+	case len(t.URL) == 0:
+		return t.Text
+
+	case style == LinkStyleURL:
+		return t.URL
+
+	case style == LinkStyleHyperlink:
+		return "\x1b]8;;" + stripControlChars(t.URL) + "\x1b\\" +
+			stripControlChars(text) + "\x1b]8;;\x1b\\"
+
+	case text == t.URL:
+		return t.URL
+
+	default:
+		return text + " (" + t.URL + ")"
+	}
+}
+
+// stripControlChars prevents values from terminating escape sequences
+func stripControlChars(str string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, str)
+}
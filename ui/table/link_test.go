@@ -0,0 +1,79 @@
+package table_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueLink(t *testing.T) {
+	link := ValueLink{Text: "docs", URL: "https://example.com/docs"}
+
+	t.Run("returns text followed by URL", func(t *testing.T) {
+		assert.Equal(t, link.String(), "docs (https://example.com/docs)")
+		assert.Equal(t, link.Format(LinkStyleDefault), "docs (https://example.com/docs)")
+		assert.Equal(t, ValueLink{URL: "https://example.com"}.String(), "https://example.com")
+		assert.Equal(t, ValueLink{Text: "docs"}.String(), "docs")
+	})
+
+	t.Run("returns only URL", func(t *testing.T) {
+		assert.Equal(t, link.Format(LinkStyleURL), "https://example.com/docs")
+	})
+
+	t.Run("returns terminal hyperlink", func(t *testing.T) {
+		assert.Equal(t, link.Format(LinkStyleHyperlink),
+			"\x1b]8;;https://example.com/docs\x1b\\docs\x1b]8;;\x1b\\")
+
+		assert.Equal(t, ValueLink{Text: "a\x1b\\b", URL: "https://x\x07"}.Format(LinkStyleHyperlink),
+			"\x1b]8;;https://x\x1b\\a\\b\x1b]8;;\x1b\\")
+	})
+
+	t.Run("returns int based on text and URL compare", func(t *testing.T) {
+		assert.Equal(t, link.Compare(link), 0)
+		assert.Equal(t, link.Compare(ValueLink{Text: "docs", URL: "https://z"}), -1)
+		assert.Equal(t, ValueLink{Text: "z"}.Compare(link), 1)
+	})
+
+	t.Run("returns text and URL as JSON value", func(t *testing.T) {
+		assert.Equal(t, JSONValueOf(link), map[string]interface{}{"Text": "docs", "URL": "https://example.com/docs"})
+	})
+
+	t.Run("ignores escape sequences when aligning hyperlinks", func(t *testing.T) {
+		table := Table{
+			Header: []Header{NewHeader("Link"), NewHeader("Name")},
+			Rows: [][]Value{
+				{ValueLink{Text: "docs", URL: "https://d"}, ValueString{S: "a"}},
+				{ValueFmt{V: ValueLink{Text: "dashboard", URL: "https://db"}}, ValueString{S: "b"}},
+				{ValueString{S: "\x1b[31mred\x1b[0m"}, ValueString{S: "c"}},
+			},
+			BorderStr: "|",
+			LinkStyle: LinkStyleHyperlink,
+		}
+
+		buf := bytes.NewBufferString("")
+		assert.Equal(t, table.Print(buf), nil)
+		assert.Equal(t, "\n"+buf.String(), "\n"+
+			"Link     |Name|\n"+
+			"\x1b]8;;https://d\x1b\\docs\x1b]8;;\x1b\\     |a|\n"+
+			"\x1b]8;;https://db\x1b\\dashboard\x1b]8;;\x1b\\|b|\n"+
+			"\x1b[31mred\x1b[0m      |c|\n")
+	})
+
+	t.Run("shows only URLs", func(t *testing.T) {
+		table := Table{
+			Header:    []Header{NewHeader("Link")},
+			Rows:      [][]Value{{link}},
+			BorderStr: "|",
+			LinkStyle: LinkStyleURL,
+		}
+
+		buf := bytes.NewBufferString("")
+		assert.Equal(t, table.Print(buf), nil)
+		assert.Equal(t, "\n"+buf.String(), `
+Link|
+https://example.com/docs|
+`)
+	})
+}
@@ -0,0 +1,103 @@
+package table
+
+import (
+	"fmt"
+	"io"
+)
+
+type StatusLevel string
+
+const (
+	StatusOK         StatusLevel = "ok"
+	StatusWarning    StatusLevel = "warning"
+	StatusError      StatusLevel = "error"
+	StatusInfo       StatusLevel = "info"
+	StatusUnknown    StatusLevel = "unknown"
+	StatusInProgress StatusLevel = "in-progress"
+)
+
+type statusSymbols struct {
+	Unicode string
+	ASCII   string
+}
+
+var statusLevelSymbols = map[StatusLevel]statusSymbols{
+	StatusOK:         {"✓", "+"},
+	StatusWarning:    {"⚠", "!"},
+	StatusError:      {"✗", "x"},
+	StatusInfo:       {"ℹ", "i"},
+	StatusUnknown:    {"?", "?"},
+	StatusInProgress: {"…", "~"},
+}
+
+// statusSeverities orders levels from least to most severe
+var statusSeverities = map[StatusLevel]int{
+	StatusOK:         0,
+	StatusInfo:       1,
+	StatusInProgress: 2,
+	StatusUnknown:    3,
+	StatusWarning:    4,
+	StatusError:      5,
+}
+
+func NewValueStatus(level StatusLevel, text string) ValueStatus {
+	return ValueStatus{Level: level, Text: text}
+}
+
+// Symbol returns level symbol falling back to ASCII for non-UTF-8 locales
+func (l StatusLevel) Symbol() string {
+	symbols, found := statusLevelSymbols[l]
+	if !found {
+		symbols = statusLevelSymbols[StatusUnknown]
+	}
+	if isUTF8Locale() {
+		return symbols.Unicode
+	}
+	return symbols.ASCII
+}
+
+func (l StatusLevel) severity() int {
+	severity, found := statusSeverities[l]
+	if !found {
+		return statusSeverities[StatusUnknown]
+	}
+	return severity
+}
+
+func (t ValueStatus) String() string {
+	if !t.Symbol {
+		return t.Text
+	}
+	if len(t.Text) == 0 {
+		return t.Level.Symbol()
+	}
+	return t.Level.Symbol() + " " + t.Text
+}
+
+func (t ValueStatus) Value() Value { return t }
+
+// Compare orders statuses by severity and then by text
+func (t ValueStatus) Compare(other Value) int {
+	otherStatus := other.(ValueStatus)
+	severity, otherSeverity := t.Level.severity(), otherStatus.Level.severity()
+
+	switch {
+	case severity < otherSeverity:
+		return -1
+	case severity > otherSeverity:
+		return 1
+	default:
+		return ValueString{S: t.Text}.Compare(ValueString{S: otherStatus.Text})
+	}
+}
+
+func (t ValueStatus) Fprintf(w io.Writer, pattern string, rest ...interface{}) (int, error) {
+	if t.Func == nil {
+		return fmt.Fprintf(w, pattern, rest...)
+	}
+	return fmt.Fprintf(w, "%s", t.Func(pattern, rest...))
+}
+
+func (t ValueStatus) JSONValue() interface{} {
+	return map[string]interface{}{"Level": t.Level, "Text": t.Text}
+}
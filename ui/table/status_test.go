@@ -0,0 +1,83 @@
+package table_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueStatus(t *testing.T) {
+	t.Run("returns text", func(t *testing.T) {
+		assert.Equal(t, ValueStatus{Level: StatusWarning, Text: "degraded"}.String(), "degraded")
+	})
+
+	t.Run("returns text prefixed with level symbol", func(t *testing.T) {
+		t.Setenv("LC_ALL", "en_US.UTF-8")
+
+		assert.Equal(t, ValueStatus{Level: StatusOK, Text: "running", Symbol: true}.String(), "✓ running")
+		assert.Equal(t, ValueStatus{Level: StatusWarning, Text: "degraded", Symbol: true}.String(), "⚠ degraded")
+		assert.Equal(t, ValueStatus{Level: StatusError, Symbol: true}.String(), "✗")
+	})
+
+	t.Run("falls back to ASCII symbols for non-UTF-8 locales", func(t *testing.T) {
+		t.Setenv("LC_ALL", "C")
+
+		assert.Equal(t, ValueStatus{Level: StatusOK, Text: "running", Symbol: true}.String(), "+ running")
+		assert.Equal(t, ValueStatus{Level: StatusWarning, Text: "degraded", Symbol: true}.String(), "! degraded")
+		assert.Equal(t, ValueStatus{Level: StatusError, Text: "failed", Symbol: true}.String(), "x failed")
+		assert.Equal(t, ValueStatus{Level: StatusInProgress, Text: "pending", Symbol: true}.String(), "~ pending")
+		assert.Equal(t, ValueStatus{Level: "other", Text: "?", Symbol: true}.String(), "? ?")
+	})
+
+	t.Run("returns int based on severity and text compare", func(t *testing.T) {
+		ok := ValueStatus{Level: StatusOK, Text: "b"}
+
+		assert.Equal(t, ok.Compare(ValueStatus{Level: StatusOK, Text: "b"}), 0)
+		assert.Equal(t, ok.Compare(ValueStatus{Level: StatusOK, Text: "c"}), -1)
+		assert.Equal(t, ok.Compare(ValueStatus{Level: StatusWarning, Text: "a"}), -1)
+		assert.Equal(t, ValueStatus{Level: StatusError}.Compare(ValueStatus{Level: StatusWarning}), 1)
+		assert.Equal(t, ValueStatus{Level: "other"}.Compare(ValueStatus{Level: StatusUnknown}), 0)
+	})
+
+	t.Run("uses custom format function", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		status := ValueStatus{
+			Level: StatusOK,
+			Text:  "running",
+			Func:  func(pattern string, args ...interface{}) string { return "<" + fmt.Sprintf(pattern, args...) + ">" },
+		}
+
+		_, err := status.Fprintf(buf, "%s", status.String())
+		assert.Equal(t, err, nil)
+		assert.Equal(t, buf.String(), "<running>")
+	})
+
+	t.Run("returns level and text as JSON value", func(t *testing.T) {
+		assert.Equal(t, JSONValueOf(ValueStatus{Level: StatusOK, Text: "running", Symbol: true}),
+			map[string]interface{}{"Level": StatusOK, "Text": "running"})
+	})
+
+	t.Run("aligns cells with multi-byte symbols", func(t *testing.T) {
+		t.Setenv("LC_ALL", "en_US.UTF-8")
+
+		table := Table{
+			Header: []Header{NewHeader("Status"), NewHeader("Name")},
+			Rows: [][]Value{
+				{ValueStatus{Level: StatusOK, Text: "ok", Symbol: true}, ValueString{S: "a"}},
+				{ValueStatus{Level: StatusError, Text: "failed", Symbol: true}, ValueString{S: "b"}},
+			},
+			BorderStr: "|",
+		}
+
+		buf := bytes.NewBufferString("")
+		assert.Equal(t, table.Print(buf), nil)
+		assert.Equal(t, "\n"+buf.String(), `
+Status  |Name|
+✓ ok    |a|
+✗ failed|b|
+`)
+	})
+}
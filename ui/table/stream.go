@@ -0,0 +1,122 @@
+package table
+
+import (
+	"fmt"
+	"io"
+)
+
+const defaultStreamSampleSize = 100
+
+// RowsFromChan returns iterator that receives rows until channel is closed
+func RowsFromChan(rows <-chan []Value) RowIterator {
+	return func() ([]Value, bool) {
+		row, ok := <-rows
+		return row, ok
+	}
+}
+
+// PrintStream prints rows as they are received without keeping them in memory.
+// Column widths are determined from first rows (see StreamOpts); later values
+// that are wider than their columns are not truncated. Rows are printed
+// in the order they are received: table's rows, sections, sorting,
+// grouping and footers are ignored since they require all rows.
+func (t Table) PrintStream(w io.Writer, rows RowIterator, opts StreamOpts) error {
+	if t.Transpose {
+		return fmt.Errorf("Expected table to not be transposed when streaming")
+	}
+
+	if !t.DataOnly {
+		err := t.printHeader(w)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !t.ShowWideColumns {
+		t.Header = hideWideHeaders(t.Header)
+	}
+
+	if len(t.BackgroundStr) == 0 {
+		t.BackgroundStr = " "
+	}
+
+	if len(t.BorderStr) == 0 {
+		t.BorderStr = "  "
+	}
+
+	writer := NewWriter(w, "-", t.BackgroundStr, t.BorderStr)
+	writer.SetBorderStyle(t.BorderStyle)
+	writer.SetLinkStyle(t.LinkStyle)
+
+	var visibleIdx int
+
+	for _, h := range t.Header {
+		if h.Hidden {
+			continue
+		}
+		if width, found := opts.MinWidths[h.Key]; found {
+			writer.SetMinWidth(visibleIdx, width)
+		}
+		visibleIdx++
+	}
+
+	sampleSize := opts.SampleSize
+	if sampleSize <= 0 {
+		sampleSize = defaultStreamSampleSize
+	}
+
+	if !t.DataOnly && len(t.Header) > 0 {
+		writer.WriteHeader(t.Header, buildHeaderVals(t))
+	}
+
+	dupVal := t.duplicateValue()
+
+	var rowCount int
+	var lastFirstVal string
+
+	for {
+		row, ok := rows()
+		if !ok {
+			break
+		}
+
+		row = append([]Value{}, row...)
+
+		for i, val := range row {
+			if val == nil {
+				row[i] = ValueNone{}
+			}
+		}
+
+		// Dedup first column against previous row only
+		if !t.FillFirstColumn && len(row) > 0 {
+			firstVal := row[0].String()
+			if rowCount > 0 && firstVal == lastFirstVal {
+				row[0] = dupVal
+			}
+			lastFirstVal = firstVal
+		}
+
+		writer.Write(t.Header, row)
+		rowCount++
+
+		// Rows are written in batches (first batch fixes column widths)
+		if rowCount%sampleSize == 0 {
+			err := writer.FlushRows()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err := writer.Flush()
+	if err != nil {
+		return err
+	}
+
+	if !t.DataOnly {
+		err = t.printFooter(w, rowCount)
+	}
+
+	return err
+}
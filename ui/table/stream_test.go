@@ -0,0 +1,269 @@
+package table_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTablePrintStream(t *testing.T) {
+	sliceRows := func(rows ...[]Value) RowIterator {
+		return func() ([]Value, bool) {
+			if len(rows) == 0 {
+				return nil, false
+			}
+			row := rows[0]
+			rows = rows[1:]
+			return row, true
+		}
+	}
+
+	t.Run("prints rows in order they are received", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+
+		table := Table{
+			Title:   "Title",
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Notes:     []string{"note1"},
+			BorderStr: "|",
+		}
+
+		err := table.PrintStream(buf, sliceRows(
+			[]Value{ValueString{S: "name2"}, ValueString{S: "2.0"}},
+			[]Value{ValueString{S: "name1"}, ValueString{S: "1.0"}},
+		), StreamOpts{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "\n"+buf.String(), `
+Title
+
+Name |Version|
+name2|2.0|
+name1|1.0|
+
+note1
+
+2 things
+`)
+	})
+
+	t.Run("determines column widths from sampled rows", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+
+		table := Table{
+			Title:   "Title",
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Notes:     []string{"note1"},
+			BorderStr: "|",
+		}
+
+		err := table.PrintStream(buf, sliceRows(
+			[]Value{ValueString{S: "n1"}, ValueString{S: "1.0"}},
+			[]Value{ValueString{S: "longer-name"}, ValueString{S: "2.0"}},
+			[]Value{ValueString{S: "n3"}, ValueString{S: "3.0"}},
+		), StreamOpts{SampleSize: 1})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "\n"+buf.String(), `
+Title
+
+Name|Version|
+n1  |1.0|
+longer-name|2.0|
+n3  |3.0|
+
+note1
+
+3 things
+`)
+	})
+
+	t.Run("uses min widths for columns", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Title:   "Title",
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Notes:     []string{"note1"},
+			BorderStr: "|",
+			DataOnly:  true,
+		}
+
+		err := table.PrintStream(buf, sliceRows(
+			[]Value{ValueString{S: "n1"}, ValueString{S: "1.0"}},
+			[]Value{ValueString{S: "longer-name"}, ValueString{S: "2.0"}},
+		), StreamOpts{SampleSize: 1, MinWidths: map[string]int{"name": 11}})
+		assert.NoError(t, err)
+
+		assert.Equal(t, buf.String(), "n1         |1.0|\nlonger-name|2.0|\n")
+	})
+
+	t.Run("writes rows after sample before receiving remaining rows", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Title:   "Title",
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Notes:     []string{"note1"},
+			BorderStr: "|",
+			DataOnly:  true,
+		}
+
+		var outputs []string
+
+		rows := sliceRows(
+			[]Value{ValueString{S: "n1"}, ValueString{S: "1.0"}},
+			[]Value{ValueString{S: "n2"}, ValueString{S: "2.0"}},
+		)
+
+		err := table.PrintStream(buf, func() ([]Value, bool) {
+			outputs = append(outputs, buf.String())
+			return rows()
+		}, StreamOpts{SampleSize: 1})
+		assert.NoError(t, err)
+
+		assert.Equal(t, outputs, []string{"", "n1|1.0|\n", "n1|1.0|\nn2|2.0|\n"})
+	})
+
+	t.Run("writes rows in batches of sample size", func(t *testing.T) {
+		w := &recordingWriter{}
+		table := Table{Header: []Header{NewHeader("Name")}, DataOnly: true}
+
+		err := table.PrintStream(w, sliceRows(
+			[]Value{ValueString{S: "n1"}},
+			[]Value{ValueString{S: "n2"}},
+			[]Value{ValueString{S: "n3"}},
+			[]Value{ValueString{S: "n4"}},
+			[]Value{ValueString{S: "n5"}},
+		), StreamOpts{SampleSize: 2})
+		assert.NoError(t, err)
+
+		assert.Equal(t, w.writes, []string{"n1  \nn2  \n", "n3  \nn4  \n", "n5  \n"})
+	})
+
+	t.Run("dedups first column against previous row", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Title:   "Title",
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Notes:     []string{"note1"},
+			BorderStr: "|",
+			DataOnly:  true,
+		}
+
+		err := table.PrintStream(buf, sliceRows(
+			[]Value{ValueString{S: "n1"}, ValueString{S: "1.0"}},
+			[]Value{ValueString{S: "n1"}, ValueString{S: "1.1"}},
+			[]Value{ValueString{S: "n2"}, ValueString{S: "2.0"}},
+			[]Value{ValueString{S: "n1"}, ValueString{S: "1.2"}},
+		), StreamOpts{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, buf.String(), "n1|1.0|\n^ |1.1|\nn2|2.0|\nn1|1.2|\n")
+	})
+
+	t.Run("receives rows from a channel", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Title:   "Title",
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Notes:     []string{"note1"},
+			BorderStr: "|",
+			DataOnly:  true,
+		}
+
+		rows := make(chan []Value)
+
+		go func() {
+			rows <- []Value{ValueString{S: "n1"}, ValueString{S: "1.0"}}
+			rows <- []Value{ValueString{S: "n2"}, nil}
+			close(rows)
+		}()
+
+		err := table.PrintStream(buf, RowsFromChan(rows), StreamOpts{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, buf.String(), "n1|1.0|\nn2|-|\n")
+	})
+
+	t.Run("draws frames around streamed rows", func(t *testing.T) {
+		buf := bytes.NewBufferString("")
+		table := Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			BorderStr:   "|",
+			BorderStyle: BorderStyleASCII,
+		}
+
+		err := table.PrintStream(buf, sliceRows(
+			[]Value{ValueString{S: "n1"}, ValueString{S: "1.0"}},
+			[]Value{ValueString{S: "n2"}, ValueString{S: "2.0"}},
+		), StreamOpts{SampleSize: 1})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "\n"+buf.String(), `
++------+---------+
+| Name | Version |
++------+---------+
+| n1   | 1.0     |
++------+---------+
+| n2   | 2.0     |
++------+---------+
+
+2 things
+`)
+	})
+
+	t.Run("returns error for transposed tables", func(t *testing.T) {
+		table := Table{
+			Title:   "Title",
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("Version"),
+			},
+			Notes:     []string{"note1"},
+			BorderStr: "|",
+			Transpose: true,
+		}
+
+		err := table.PrintStream(bytes.NewBufferString(""), sliceRows(), StreamOpts{})
+		assert.EqualError(t, err, "Expected table to not be transposed when streaming")
+	})
+}
+
+type recordingWriter struct {
+	writes []string
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	w.writes = append(w.writes, string(p))
+	return len(p), nil
+}
@@ -0,0 +1,60 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StructTagName is a name of struct field tag used to describe columns
+const StructTagName = "table"
+
+// ParseStructTag parses value of `table` struct field tag
+func ParseStructTag(tag string) (StructTag, error) {
+	if tag == "-" {
+		return StructTag{Skip: true}, nil
+	}
+
+	pieces := strings.Split(tag, ",")
+
+	result := StructTag{Title: pieces[0]}
+
+	for _, opt := range pieces[1:] {
+		name, val := opt, ""
+
+		if idx := strings.Index(opt, "="); idx >= 0 {
+			name, val = opt[:idx], opt[idx+1:]
+		}
+
+		switch name {
+		case "key", "method":
+			if len(val) == 0 {
+				return StructTag{}, fmt.Errorf("Expected struct tag option '%s' to have a value", name)
+			}
+			if name == "key" {
+				result.Key = val
+			} else {
+				result.Method = val
+			}
+		case "sort":
+			if val != "asc" && val != "desc" {
+				return StructTag{}, fmt.Errorf("Expected struct tag option 'sort' to be 'asc' or 'desc'")
+			}
+			result.Sort = val
+		case "wide":
+			result.Wide = true
+		case "hidden":
+			result.Hidden = true
+		default:
+			return StructTag{}, fmt.Errorf("Unknown struct tag option '%s'", name)
+		}
+	}
+
+	if len(result.Key) == 0 {
+		if len(result.Title) == 0 {
+			return StructTag{}, fmt.Errorf("Expected struct tag to specify title or key")
+		}
+		result.Key = KeyifyHeader(result.Title)
+	}
+
+	return result, nil
+}
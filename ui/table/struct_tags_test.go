@@ -0,0 +1,59 @@
+package table_test
+
+import (
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStructTag(t *testing.T) {
+	t.Run("uses keyified title as key", func(t *testing.T) {
+		tag, err := ParseStructTag("Created At")
+		assert.Equal(t, err, nil)
+		assert.Equal(t, tag, StructTag{Title: "Created At", Key: "created_at"})
+	})
+
+	t.Run("uses explicit key", func(t *testing.T) {
+		tag, err := ParseStructTag("Name,key=full_name")
+		assert.Equal(t, err, nil)
+		assert.Equal(t, tag, StructTag{Title: "Name", Key: "full_name"})
+
+		tag, err = ParseStructTag(",key=name")
+		assert.Equal(t, err, nil)
+		assert.Equal(t, tag, StructTag{Key: "name"})
+	})
+
+	t.Run("parses sort, wide, hidden and method options", func(t *testing.T) {
+		tag, err := ParseStructTag("Name,sort=desc,wide,hidden")
+		assert.Equal(t, err, nil)
+		assert.Equal(t, tag, StructTag{Title: "Name", Key: "name", Sort: "desc", Wide: true, Hidden: true})
+
+		tag, err = ParseStructTag("Age,method=Age")
+		assert.Equal(t, err, nil)
+		assert.Equal(t, tag, StructTag{Title: "Age", Key: "age", Method: "Age"})
+	})
+
+	t.Run("skips fields tagged with '-'", func(t *testing.T) {
+		tag, err := ParseStructTag("-")
+		assert.Equal(t, err, nil)
+		assert.Equal(t, tag, StructTag{Skip: true})
+	})
+
+	t.Run("returns error for invalid tags", func(t *testing.T) {
+		_, err := ParseStructTag("")
+		assert.Equal(t, err.Error(), "Expected struct tag to specify title or key")
+
+		_, err = ParseStructTag("Name,key=")
+		assert.Equal(t, err.Error(), "Expected struct tag option 'key' to have a value")
+
+		_, err = ParseStructTag("Name,sort=up")
+		assert.Equal(t, err.Error(), "Expected struct tag option 'sort' to be 'asc' or 'desc'")
+
+		_, err = ParseStructTag("Name,method")
+		assert.Equal(t, err.Error(), "Expected struct tag option 'method' to have a value")
+
+		_, err = ParseStructTag("Name,unknown")
+		assert.Equal(t, err.Error(), "Unknown struct tag option 'unknown'")
+	})
+}
@@ -3,14 +3,81 @@ package table
 import (
 	"fmt"
 	"io"
-	"sort"
 	"strings"
 )
 
 func (t Table) AsRows() [][]Value {
-	rows := [][]Value{}
+	rows := t.sortedRows()
+
+	// Dedup first (or grouped) columns
+	if !t.FillFirstColumn {
+		t.dedupColumns(rows, t.dedupedColumns())
+	}
+
+	return rows
+}
+
+// FooterRows computes footer rows based on all (non-footer) rows
+func (t Table) FooterRows() ([][]Value, error) {
+	if len(t.Footer) == 0 {
+		return nil, nil
+	}
+
+	rows := t.collectRows()
+
+	numCols := len(t.Header)
+	for _, r := range rows {
+		if len(r) > numCols {
+			numCols = len(r)
+		}
+	}
+
+	return aggregateRows(t.Footer, rows, numCols)
+}
+
+func aggregateRows(footers []FooterRow, rows [][]Value, numCols int) ([][]Value, error) {
+	err := checkAggregateColumns(footers, numCols)
+	if err != nil {
+		return nil, err
+	}
 
-	totalRows := 0
+	var result [][]Value
+
+	for _, footer := range footers {
+		row := make([]Value, numCols)
+		for i := range row {
+			row[i] = blankValue{}
+		}
+
+		for _, agg := range footer.Aggregates {
+			var vals []Value
+			for _, r := range rows {
+				if agg.Column < len(r) {
+					vals = append(vals, r[agg.Column])
+				}
+			}
+			row[agg.Column] = agg.Func(vals)
+		}
+
+		result = append(result, row)
+	}
+
+	return result, nil
+}
+
+func checkAggregateColumns(footers []FooterRow, numCols int) error {
+	for _, footer := range footers {
+		for _, agg := range footer.Aggregates {
+			if agg.Column < 0 || agg.Column >= numCols {
+				return fmt.Errorf("Expected aggregated column %d to be less than number of columns (%d)", agg.Column, numCols)
+			}
+		}
+	}
+	return nil
+}
+
+func (t Table) collectRows() [][]Value {
+	rows := [][]Value{}
 
 	if len(t.Sections) > 0 {
 		for _, s := range t.Sections {
@@ -22,19 +89,15 @@ func (t Table) AsRows() [][]Value {
 				}
 			}
 
-			totalRows += len(s.Rows)
-
 			for _, r := range s.Rows {
-				rows = append(rows, r)
+				rows = append(rows, append([]Value{}, r...))
 			}
 		}
 	}
 
 	if len(t.Rows) > 0 {
-		totalRows += len(t.Rows)
-
 		for _, r := range t.Rows {
-			rows = append(rows, r)
+			rows = append(rows, append([]Value{}, r...))
 		}
 	}
 
@@ -47,32 +110,31 @@ func (t Table) AsRows() [][]Value {
 		}
 	}
 
-	// Sort all rows
-	sort.Sort(Sorting{t.SortBy, rows})
+	return rows
+}
 
-	// Dedup first column
-	if !t.FillFirstColumn {
-		var lastVal Value
-
-		for _, r := range rows {
-			if lastVal == nil {
-				lastVal = r[0]
-			} else if lastVal.String() == r[0].String() {
-				if len(t.DuplicateStr) > 0 {
-					r[0] = ValueString{t.DuplicateStr}
-				} else {
-					r[0] = ValueString{"^"}
-				}
-			} else {
-				lastVal = r[0]
-			}
+func (t Table) Print(w io.Writer) error {
+	groupCols, err := t.groupColumns()
+	if err != nil {
+		return fmt.Errorf("Grouping rows: %s", err)
+	}
+
+	if len(groupCols) > 0 {
+		err = checkAggregateColumns(t.GroupBy.Subtotals, len(t.Header))
+		if err != nil {
+			return fmt.Errorf("Grouping rows: %s", err)
 		}
 	}
 
-	return rows
-}
+	var footerRows [][]Value
+
+	if !t.DataOnly {
+		footerRows, err = t.FooterRows()
+		if err != nil {
+			return fmt.Errorf("Aggregating footer rows: %s", err)
+		}
+	}
 
-func (t Table) Print(w io.Writer) error {
 	if !t.DataOnly {
 		err := t.printHeader(w)
 		if err != nil {
@@ -80,6 +142,10 @@ func (t Table) Print(w io.Writer) error {
 		}
 	}
 
+	if !t.ShowWideColumns {
+		t.Header = hideWideHeaders(t.Header)
+	}
+
 	if len(t.BackgroundStr) == 0 {
 		t.BackgroundStr = " "
 	}
@@ -89,51 +155,105 @@ func (t Table) Print(w io.Writer) error {
 	}
 
 	writer := NewWriter(w, "-", t.BackgroundStr, t.BorderStr)
+	writer.SetBorderStyle(t.BorderStyle)
+	writer.SetLinkStyle(t.LinkStyle)
+
 	rowCount := len(t.Rows)
 	for _, section := range t.Sections {
 		rowCount += len(section.Rows)
 	}
 
-	rows := t.AsRows()
+	var rows [][]Value
+	var groups []rowGroup
+
+	if len(groupCols) > 0 && !t.DataOnly && !t.Transpose {
+		rows = t.sortedRows()
+		groups = t.splitGroups(rows, groupCols)
+
+		if !t.FillFirstColumn {
+			t.dedupColumns(rows, groupCols)
+		}
+	} else {
+		rows = t.AsRows()
+	}
+
+	// Transposed rows keep formatting of their original columns
+	var rowHeaders [][]Header
 
 	if t.Transpose {
 		var newRows [][]Value
 
 		headerVals := buildHeaderVals(t)
+		spacerHeaders := []Header{{Hidden: t.DataOnly}, {Hidden: false}}
+
+		addRow := func(j int, val Value) {
+			newRows = append(newRows, []Value{headerVals[j], val})
+			rowHeaders = append(rowHeaders, []Header{
+				{Hidden: t.DataOnly},
+				{Alignment: t.Header[j].Alignment, NumberFormat: t.Header[j].NumberFormat},
+			})
+		}
+
+		addSpacer := func() {
+			newRows = append(newRows, []Value{EmptyValue{}, EmptyValue{}})
+			rowHeaders = append(rowHeaders, spacerHeaders)
+		}
 
 		for i, row := range rows {
 			for j, val := range row {
 				if t.Header[j].Hidden {
 					continue
 				}
-
-				newRows = append(newRows, []Value{headerVals[j], val})
+				addRow(j, val)
 			}
 
 			if i < (len(t.Rows) - 1) {
-				newRows = append(newRows, []Value{
-					EmptyValue{},
-					EmptyValue{},
-				})
+				addSpacer()
 			}
 		}
 
-		rows = newRows
-		t.Header = []Header{
-			{Hidden: t.DataOnly},
-			{Hidden: false},
+		for _, row := range footerRows {
+			if len(newRows) > 0 {
+				addSpacer()
+			}
+			for j, val := range row {
+				if _, isBlank := val.(blankValue); isBlank || t.Header[j].Hidden {
+					continue
+				}
+				addRow(j, val)
+			}
 		}
+
+		rows = newRows
+		footerRows = nil
+		t.Header = spacerHeaders
 	} else {
 		if !t.DataOnly && len(t.Header) > 0 {
-			writer.Write(t.Header, buildHeaderVals(t))
+			writer.WriteHeader(t.Header, buildHeaderVals(t))
 		}
 	}
 
-	for _, row := range rows {
-		writer.Write(t.Header, row)
+	if len(groups) > 0 {
+		t.writeGroups(writer, groups)
+	} else {
+		for i, row := range rows {
+			if rowHeaders != nil {
+				writer.Write(rowHeaders[i], row)
+			} else {
+				writer.Write(t.Header, row)
+			}
+		}
 	}
 
-	err := writer.Flush()
+	if len(footerRows) > 0 {
+		writer.WriteRule()
+
+		for _, row := range footerRows {
+			writer.Write(t.Header, row)
+		}
+	}
+
+	err = writer.Flush()
 	if err != nil {
 		return err
 	}
@@ -157,6 +277,17 @@ func (t Table) AddColumn(header string, values []Value) Table {
 	return t
 }
 
+func hideWideHeaders(headers []Header) []Header {
+	var result []Header
+	for _, h := range headers {
+		if h.Wide {
+			h.Hidden = true
+		}
+		result = append(result, h)
+	}
+	return result
+}
+
 func buildHeaderVals(t Table) []Value {
 	var headerVals []Value
 
@@ -2,8 +2,11 @@ package table_test
 
 import (
 	"bytes"
+	"fmt"
+	"io/ioutil"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/cppforlife/go-cli-ui/ui/table"
 	"github.com/stretchr/testify/assert"
@@ -119,10 +122,10 @@ note2
 			table.Print(buf)
 			assert.Equal(t, "\n"+buf.String(), `
 d|100|
-c|20|
-d|20|
-b|0|
-a|-1|
+c|.20|
+d|.20|
+b|..0|
+a|.-1|
 `)
 		})
 
@@ -614,6 +617,223 @@ Header3.....|r2c3|
 Header1|Header2|
 v1     |v2|
 
+1 content
+`)
+			})
+		})
+		t.Run("when footer is provided", func(t *testing.T) {
+			t.Run("prints footer after sorted rows and excludes it from the count", func(t *testing.T) {
+				buf := bytes.NewBufferString("")
+				table := Table{
+					Content: "things",
+					Header: []Header{
+						NewHeader("Name"),
+						NewHeader("Instances"),
+						NewHeader("Disk"),
+					},
+					SortBy: []ColumnSort{{Column: 0, Asc: true}},
+					Rows: [][]Value{
+						{ValueString{S: "b"}, ValueInt{I: 2}, ValueInt{I: 10}},
+						{ValueString{S: "a"}, ValueInt{I: 3}, ValueInt{I: 20}},
+					},
+					Footer: []FooterRow{
+						{
+							Aggregates: []ColumnAggregate{
+								{Column: 0, Func: AggregateLabel("Total")},
+								{Column: 1, Func: AggregateSum},
+							},
+						},
+						{
+							Aggregates: []ColumnAggregate{
+								{Column: 0, Func: AggregateLabel("Max")},
+								{Column: 2, Func: AggregateMax},
+							},
+						},
+					},
+					BorderStr: "|",
+				}
+				table.Print(buf)
+				assert.Equal(t, "\n"+buf.String(), `
+Name |Instances|Disk|
+a    |        3|  20|
+b    |        2|  10|
+-----|---------|----|
+Total|        5||
+Max  |         |  20|
+
+2 things
+`)
+			})
+
+			t.Run("does not print footer for data only tables", func(t *testing.T) {
+				buf := bytes.NewBufferString("")
+				table := Table{
+					Content: "things",
+					Header: []Header{
+						NewHeader("Name"),
+						NewHeader("Instances"),
+						NewHeader("Disk"),
+					},
+					SortBy: []ColumnSort{{Column: 0, Asc: true}},
+					Rows: [][]Value{
+						{ValueString{S: "b"}, ValueInt{I: 2}, ValueInt{I: 10}},
+						{ValueString{S: "a"}, ValueInt{I: 3}, ValueInt{I: 20}},
+					},
+					Footer: []FooterRow{
+						{
+							Aggregates: []ColumnAggregate{
+								{Column: 0, Func: AggregateLabel("Total")},
+								{Column: 1, Func: AggregateSum},
+							},
+						},
+						{
+							Aggregates: []ColumnAggregate{
+								{Column: 0, Func: AggregateLabel("Max")},
+								{Column: 2, Func: AggregateMax},
+							},
+						},
+					},
+					BorderStr: "|",
+					DataOnly:  true,
+				}
+				table.Print(buf)
+				assert.Equal(t, "\n"+buf.String(), `
+a|3|20|
+b|2|10|
+`)
+			})
+
+			t.Run("prints footer as a separate record in transposed tables", func(t *testing.T) {
+				buf := bytes.NewBufferString("")
+				table := Table{
+					Content: "things",
+					Header: []Header{
+						NewHeader("Name"),
+						NewHeader("Instances"),
+						NewHeader("Disk"),
+					},
+					SortBy: []ColumnSort{{Column: 0, Asc: true}},
+					Rows: [][]Value{
+						{ValueString{S: "b"}, ValueInt{I: 2}, ValueInt{I: 10}},
+						{ValueString{S: "a"}, ValueInt{I: 3}, ValueInt{I: 20}},
+					},
+					Footer: []FooterRow{
+						{
+							Aggregates: []ColumnAggregate{
+								{Column: 0, Func: AggregateLabel("Total")},
+								{Column: 1, Func: AggregateSum},
+							},
+						},
+						{
+							Aggregates: []ColumnAggregate{
+								{Column: 0, Func: AggregateLabel("Max")},
+								{Column: 2, Func: AggregateMax},
+							},
+						},
+					},
+					BorderStr: "|",
+				}
+				table.Rows = table.Rows[:1]
+				table.Footer = table.Footer[:1]
+				table.Transpose = true
+				table.Print(buf)
+				assert.Equal(t, "\n"+buf.String(), `
+Name     |b|
+Instances|    2|
+Disk     |   10|
+
+Name     |Total|
+Instances|    2|
+
+1 things
+`)
+			})
+
+			t.Run("returns error when footer aggregates unknown column", func(t *testing.T) {
+				buf := bytes.NewBufferString("")
+				table := Table{
+					Content: "things",
+					Header: []Header{
+						NewHeader("Name"),
+						NewHeader("Instances"),
+						NewHeader("Disk"),
+					},
+					SortBy: []ColumnSort{{Column: 0, Asc: true}},
+					Rows: [][]Value{
+						{ValueString{S: "b"}, ValueInt{I: 2}, ValueInt{I: 10}},
+						{ValueString{S: "a"}, ValueInt{I: 3}, ValueInt{I: 20}},
+					},
+					Footer: []FooterRow{
+						{
+							Aggregates: []ColumnAggregate{
+								{Column: 0, Func: AggregateLabel("Total")},
+								{Column: 1, Func: AggregateSum},
+							},
+						},
+						{
+							Aggregates: []ColumnAggregate{
+								{Column: 0, Func: AggregateLabel("Max")},
+								{Column: 2, Func: AggregateMax},
+							},
+						},
+					},
+					BorderStr: "|",
+				}
+				table.Footer[1].Aggregates[1].Column = 3
+
+				err := table.Print(buf)
+				assert.EqualError(t, err, "Aggregating footer rows: Expected aggregated column 3 to be less than number of columns (3)")
+				assert.Equal(t, buf.String(), "")
+
+				_, err = table.FooterRows()
+				assert.EqualError(t, err, "Expected aggregated column 3 to be less than number of columns (3)")
+			})
+		})
+
+		t.Run("when wide columns are used", func(t *testing.T) {
+			t.Run("does not print wide columns by default", func(t *testing.T) {
+				buf := bytes.NewBufferString("")
+				table := Table{
+					Content: "content",
+
+					Header: []Header{
+						NewHeader("Header1"),
+						{Key: "cid", Title: "CID", Wide: true},
+					},
+					Rows: [][]Value{
+						{ValueString{S: "v1"}, ValueString{S: "cid1"}},
+					},
+					BorderStr: "|",
+				}
+				table.Print(buf)
+				assert.Equal(t, "\n"+buf.String(), `
+Header1|
+v1|
+
+1 content
+`)
+			})
+
+			t.Run("prints wide columns when enabled", func(t *testing.T) {
+				buf := bytes.NewBufferString("")
+				table := Table{
+					Content: "content",
+
+					Header: []Header{
+						NewHeader("Header1"),
+						{Key: "cid", Title: "CID", Wide: true},
+					},
+					Rows: [][]Value{
+						{ValueString{S: "v1"}, ValueString{S: "cid1"}},
+					},
+					BorderStr:       "|",
+					ShowWideColumns: true,
+				}
+				table.Print(buf)
+				assert.Equal(t, "\n"+buf.String(), `
+Header1|CID|
+v1     |cid1|
+
 1 content
 `)
 			})
@@ -654,3 +874,40 @@ v1     |v2|
 		})
 	})
 }
+
+func BenchmarkTablePrint(b *testing.B) {
+	for _, numRows := range []int{10000, 100000} {
+		table := Table{
+			Content: "things",
+			Header: []Header{
+				NewHeader("Name"),
+				NewHeader("State"),
+				NewHeader("Count"),
+				NewHeader("Tags"),
+			},
+		}
+
+		for i := 0; i < numRows; i++ {
+			table.Rows = append(table.Rows, []Value{
+				ValueString{S: fmt.Sprintf("name-%d", i)},
+				ValueFmt{V: ValueString{S: "running"}, Func: fmt.Sprintf},
+				ValueInt{I: i},
+				ValueStrings{S: []string{"tag1", "tag2"}},
+			})
+		}
+
+		b.Run(fmt.Sprintf("%d rows", numRows), func(b *testing.B) {
+			b.ReportAllocs()
+			startedAt := time.Now()
+
+			for i := 0; i < b.N; i++ {
+				err := table.Print(ioutil.Discard)
+				if err != nil {
+					b.Fatalf("Printing table: %s", err)
+				}
+			}
+
+			b.ReportMetric(float64(numRows*b.N)/time.Since(startedAt).Seconds(), "rows/s")
+		})
+	}
+}
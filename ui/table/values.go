@@ -3,6 +3,7 @@ package table
 import (
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -185,3 +186,204 @@ func (t ValueSuffix) String() string {
 
 func (t ValueSuffix) Value() Value            { return t.V }
 func (t ValueSuffix) Compare(other Value) int { panic("Never called") }
+
+func NewValueInt64(i int64) ValueInt64 { return ValueInt64{I: i} }
+
+func (t ValueInt64) String() string { return strconv.FormatInt(t.I, 10) }
+func (t ValueInt64) Value() Value   { return t }
+
+func (t ValueInt64) Compare(other Value) int {
+	otherI := other.(ValueInt64).I
+	switch {
+	case t.I == otherI:
+		return 0
+	case t.I < otherI:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func NewValueUint64(i uint64) ValueUint64 { return ValueUint64{I: i} }
+
+func (t ValueUint64) String() string { return strconv.FormatUint(t.I, 10) }
+func (t ValueUint64) Value() Value   { return t }
+
+func (t ValueUint64) Compare(other Value) int {
+	otherI := other.(ValueUint64).I
+	switch {
+	case t.I == otherI:
+		return 0
+	case t.I < otherI:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func NewValueFloat(f float64) ValueFloat { return ValueFloat{F: f} }
+
+func (t ValueFloat) String() string {
+	if t.Precision > 0 {
+		return strconv.FormatFloat(t.F, 'f', t.Precision, 64)
+	}
+	return strconv.FormatFloat(t.F, 'f', -1, 64)
+}
+
+func (t ValueFloat) Value() Value            { return t }
+func (t ValueFloat) Compare(other Value) int { return compareFloats(t.F, other.(ValueFloat).F) }
+
+func NewValuePercent(f float64) ValuePercent { return ValuePercent{F: f} }
+
+func (t ValuePercent) String() string {
+	// Keep at most one decimal place (e.g. 12.5%)
+	return strconv.FormatFloat(math.Round(t.F*1000)/10, 'f', -1, 64) + "%"
+}
+
+func (t ValuePercent) Value() Value            { return t }
+func (t ValuePercent) Compare(other Value) int { return compareFloats(t.F, other.(ValuePercent).F) }
+
+func NewValueBytes(b int64) ValueBytes { return ValueBytes{B: b} }
+
+func (t ValueBytes) String() string {
+	const unit = 1024
+
+	// Magnitude of math.MinInt64 does not fit into int64
+	b := uint64(t.B)
+	sign := ""
+
+	if t.B < 0 {
+		sign = "-"
+		b = -b
+	}
+	if b < unit {
+		return fmt.Sprintf("%s%d B", sign, b)
+	}
+
+	size := float64(b)
+	units := []string{"KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	unitIdx := -1
+
+	// Unit is picked after rounding (e.g. 1048575 is 1 MiB instead of 1024 KiB)
+	for math.Round(size*10)/10 >= unit && unitIdx < len(units)-1 {
+		size /= unit
+		unitIdx++
+	}
+
+	return sign + strings.TrimSuffix(fmt.Sprintf("%.1f", size), ".0") + " " + units[unitIdx]
+}
+
+func (t ValueBytes) Value() Value { return t }
+
+func (t ValueBytes) Compare(other Value) int {
+	otherB := other.(ValueBytes).B
+	switch {
+	case t.B == otherB:
+		return 0
+	case t.B < otherB:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func NewValueDuration(d time.Duration) ValueDuration { return ValueDuration{D: d} }
+
+func (t ValueDuration) String() string { return humanDuration(t.D, 2) }
+func (t ValueDuration) Value() Value   { return t }
+
+func (t ValueDuration) Compare(other Value) int {
+	otherD := other.(ValueDuration).D
+	switch {
+	case t.D == otherD:
+		return 0
+	case t.D < otherD:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func NewValueRelativeTime(t time.Time) ValueRelativeTime { return ValueRelativeTime{T: t} }
+
+func (t ValueRelativeTime) String() string {
+	if t.T.IsZero() {
+		return ""
+	}
+
+	now := time.Now
+	if t.Clock != nil {
+		now = t.Clock
+	}
+
+	diff := now().Sub(t.T)
+
+	switch {
+	case diff > -time.Second && diff < time.Second:
+		return "now"
+	case diff < 0:
+		return "in " + humanDuration(-diff, 1)
+	default:
+		return humanDuration(diff, 1) + " ago"
+	}
+}
+
+func (t ValueRelativeTime) Value() Value { return t }
+
+func (t ValueRelativeTime) Compare(other Value) int {
+	return ValueTime{T: t.T}.Compare(ValueTime{T: other.(ValueRelativeTime).T})
+}
+
+func compareFloats(f, otherF float64) int {
+	switch {
+	case f == otherF:
+		return 0
+	case f < otherF:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// humanDuration shows up to specified number of most significant units
+// (e.g. 2d3h, 1h5m, 45s); durations under a second are shown as is
+func humanDuration(d time.Duration, maxUnits int) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	if d < time.Second {
+		return sign + d.String()
+	}
+
+	units := []struct {
+		Name string
+		D    time.Duration
+	}{
+		{"d", 24 * time.Hour},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+	}
+
+	var result string
+	var shownUnits int
+
+	for _, unit := range units {
+		if shownUnits == maxUnits {
+			break
+		}
+		if d >= unit.D {
+			result += strconv.FormatInt(int64(d/unit.D), 10) + unit.Name
+			d %= unit.D
+			shownUnits++
+		} else if shownUnits > 0 {
+			// Do not skip units between shown ones (e.g. 1d rather than 1d5m)
+			break
+		}
+	}
+
+	return sign + result
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -59,6 +60,120 @@ func TestValueInt(t *testing.T) {
 	})
 }
 
+func TestValueInt64(t *testing.T) {
+	t.Run("returns string", func(t *testing.T) {
+		assert.Equal(t, ValueInt64{I: -9007199254740993}.String(), "-9007199254740993")
+	})
+
+	t.Run("returns int based on int compare", func(t *testing.T) {
+		assert.Equal(t, ValueInt64{I: 1}.Compare(ValueInt64{I: 1}), 0)
+		assert.Equal(t, ValueInt64{I: 1}.Compare(ValueInt64{I: 2}), -1)
+		assert.Equal(t, ValueInt64{I: 2}.Compare(ValueInt64{I: 1}), 1)
+	})
+}
+
+func TestValueUint64(t *testing.T) {
+	t.Run("returns string", func(t *testing.T) {
+		assert.Equal(t, ValueUint64{I: 18446744073709551615}.String(), "18446744073709551615")
+	})
+
+	t.Run("returns int based on int compare", func(t *testing.T) {
+		assert.Equal(t, ValueUint64{I: 1}.Compare(ValueUint64{I: 1}), 0)
+		assert.Equal(t, ValueUint64{I: 1}.Compare(ValueUint64{I: 18446744073709551615}), -1)
+		assert.Equal(t, ValueUint64{I: 2}.Compare(ValueUint64{I: 1}), 1)
+	})
+}
+
+func TestValueFloat(t *testing.T) {
+	t.Run("returns shortest string unless precision is specified", func(t *testing.T) {
+		assert.Equal(t, ValueFloat{F: 1.25}.String(), "1.25")
+		assert.Equal(t, ValueFloat{F: 3}.String(), "3")
+		assert.Equal(t, ValueFloat{F: 1.256, Precision: 2}.String(), "1.26")
+	})
+
+	t.Run("returns int based on float compare", func(t *testing.T) {
+		assert.Equal(t, ValueFloat{F: 1.5}.Compare(ValueFloat{F: 1.5}), 0)
+		assert.Equal(t, ValueFloat{F: 1.5}.Compare(ValueFloat{F: 2}), -1)
+		assert.Equal(t, ValueFloat{F: 2}.Compare(ValueFloat{F: 1.5}), 1)
+	})
+}
+
+func TestValuePercent(t *testing.T) {
+	t.Run("returns fraction as percentage", func(t *testing.T) {
+		assert.Equal(t, ValuePercent{F: 0.5}.String(), "50%")
+		assert.Equal(t, ValuePercent{F: 0.1255}.String(), "12.6%")
+		assert.Equal(t, ValuePercent{F: 1.5}.String(), "150%")
+	})
+
+	t.Run("returns int based on fraction compare", func(t *testing.T) {
+		assert.Equal(t, ValuePercent{F: 0.5}.Compare(ValuePercent{F: 0.5}), 0)
+		assert.Equal(t, ValuePercent{F: 0.05}.Compare(ValuePercent{F: 0.5}), -1)
+		assert.Equal(t, ValuePercent{F: 0.5}.Compare(ValuePercent{F: 0.05}), 1)
+	})
+}
+
+func TestValueBytes(t *testing.T) {
+	t.Run("returns human readable size", func(t *testing.T) {
+		assert.Equal(t, ValueBytes{B: 0}.String(), "0 B")
+		assert.Equal(t, ValueBytes{B: 1023}.String(), "1023 B")
+		assert.Equal(t, ValueBytes{B: 1024}.String(), "1 KiB")
+		assert.Equal(t, ValueBytes{B: 1536}.String(), "1.5 KiB")
+		assert.Equal(t, ValueBytes{B: 1288490189}.String(), "1.2 GiB")
+		assert.Equal(t, ValueBytes{B: -2048}.String(), "-2 KiB")
+		assert.Equal(t, ValueBytes{B: 1048575}.String(), "1 MiB")
+		assert.Equal(t, ValueBytes{B: 1048524}.String(), "1023.9 KiB")
+		assert.Equal(t, ValueBytes{B: math.MaxInt64}.String(), "8 EiB")
+		assert.Equal(t, ValueBytes{B: math.MinInt64}.String(), "-8 EiB")
+	})
+
+	t.Run("returns int based on number of bytes compare", func(t *testing.T) {
+		assert.Equal(t, ValueBytes{B: 1024}.Compare(ValueBytes{B: 1024}), 0)
+		assert.Equal(t, ValueBytes{B: 1023}.Compare(ValueBytes{B: 1024}), -1)
+		assert.Equal(t, ValueBytes{B: 1048576}.Compare(ValueBytes{B: 1024}), 1)
+	})
+}
+
+func TestValueDuration(t *testing.T) {
+	t.Run("returns two most significant units", func(t *testing.T) {
+		assert.Equal(t, ValueDuration{D: 0}.String(), "0s")
+		assert.Equal(t, ValueDuration{D: 150 * time.Millisecond}.String(), "150ms")
+		assert.Equal(t, ValueDuration{D: 45 * time.Second}.String(), "45s")
+		assert.Equal(t, ValueDuration{D: 65*time.Minute + 10*time.Second}.String(), "1h5m")
+		assert.Equal(t, ValueDuration{D: 51 * time.Hour}.String(), "2d3h")
+		assert.Equal(t, ValueDuration{D: 24*time.Hour + 5*time.Minute}.String(), "1d")
+		assert.Equal(t, ValueDuration{D: -90 * time.Second}.String(), "-1m30s")
+	})
+
+	t.Run("returns int based on duration compare", func(t *testing.T) {
+		assert.Equal(t, ValueDuration{D: time.Second}.Compare(ValueDuration{D: time.Second}), 0)
+		assert.Equal(t, ValueDuration{D: time.Second}.Compare(ValueDuration{D: time.Minute}), -1)
+		assert.Equal(t, ValueDuration{D: time.Hour}.Compare(ValueDuration{D: time.Minute}), 1)
+	})
+}
+
+func TestValueRelativeTime(t *testing.T) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	t.Run("returns time relative to clock", func(t *testing.T) {
+		assert.Equal(t, ValueRelativeTime{T: now.Add(-5 * time.Minute), Clock: clock}.String(), "5m ago")
+		assert.Equal(t, ValueRelativeTime{T: now.Add(-50 * time.Hour), Clock: clock}.String(), "2d ago")
+		assert.Equal(t, ValueRelativeTime{T: now.Add(3 * time.Hour), Clock: clock}.String(), "in 3h")
+		assert.Equal(t, ValueRelativeTime{T: now, Clock: clock}.String(), "now")
+	})
+
+	t.Run("returns empty string for zero time", func(t *testing.T) {
+		assert.Equal(t, ValueRelativeTime{Clock: clock}.String(), "")
+	})
+
+	t.Run("returns int based on time compare", func(t *testing.T) {
+		older := ValueRelativeTime{T: now.Add(-time.Hour)}
+		assert.Equal(t, older.Compare(older), 0)
+		assert.Equal(t, older.Compare(ValueRelativeTime{T: now}), -1)
+		assert.Equal(t, ValueRelativeTime{T: now}.Compare(older), 1)
+	})
+}
+
 func TestValueTime(t *testing.T) {
 	t1 := time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)
 	t2 := time.Date(2009, time.November, 10, 23, 0, 0, 1, time.UTC)
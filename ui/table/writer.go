@@ -1,31 +1,59 @@
 package table
 
 import (
-	"fmt"
+	"bufio"
 	"io"
-	"reflect"
 	"strings"
+	"unicode/utf8"
 )
 
 type Writer struct {
-	w         io.Writer
+	w         *bufio.Writer
 	emptyStr  string
 	bgStr     string
 	borderStr string
 
+	chars     borderChars
+	linkStyle LinkStyle
+
 	rows   []writerRow
 	widths map[int]int
+
+	// Rows may be flushed in batches (e.g. when streaming);
+	// widths are not changed once first batch is written
+	started bool
+	prevRow *writerRow
+
+	// paddingStr is reused for background padding of cells
+	paddingStr string
 }
 
 type writerCell struct {
 	Value   Value
 	String  string
+	Width   int
 	IsEmpty bool
+	Align   Alignment
+}
+
+// writerCol is a cell with all lines of a multi-line value
+type writerCol struct {
+	Cell  writerCell
+	Lines []string
 }
 
 type writerRow struct {
 	Values   []writerCell
 	IsSpacer bool
+	IsRule   bool
+	IsHeader bool
+
+	// Continuation lines belong to multi-line values of a previous row
+	IsContinuation bool
+
+	// Text is printed as is without affecting column widths
+	Text   string
+	IsText bool
 }
 
 type hasCustomWriter interface {
@@ -34,7 +62,7 @@ type hasCustomWriter interface {
 
 func NewWriter(w io.Writer, emptyStr, bgStr, borderStr string) *Writer {
 	return &Writer{
-		w:         w,
+		w:         bufio.NewWriter(w),
 		emptyStr:  emptyStr,
 		bgStr:     bgStr,
 		borderStr: borderStr,
@@ -42,118 +70,362 @@ func NewWriter(w io.Writer, emptyStr, bgStr, borderStr string) *Writer {
 	}
 }
 
+// SetBorderStyle configures borders drawn around and between cells
+func (w *Writer) SetBorderStyle(style BorderStyle) {
+	w.chars = style.chars()
+}
+
+// SetLinkStyle configures how links are rendered (see ValueLink)
+func (w *Writer) SetLinkStyle(style LinkStyle) {
+	w.linkStyle = style
+}
+
+// SetMinWidth configures minimum width of a visible column
+func (w *Writer) SetMinWidth(colIdx, width int) {
+	if width > w.widths[colIdx] {
+		w.widths[colIdx] = width
+	}
+}
+
+// WriteHeader adds a row that is separated from the rest of the rows
+// when border style includes a header rule
+func (w *Writer) WriteHeader(headers []Header, vals []Value) {
+	startIdx := len(w.rows)
+	w.Write(headers, vals)
+
+	for i := startIdx; i < len(w.rows); i++ {
+		w.rows[i].IsHeader = true
+	}
+}
+
 func (w *Writer) Write(headers []Header, vals []Value) {
 	rowsToAdd := 1
-	colsWithRows := [][]writerCell{}
+	cols := make([]writerCol, 0, len(vals))
 
-	visibleHeaderIndex := 0
 	for i, val := range vals {
 		if len(headers) > 0 && headers[i].Hidden {
 			continue
 		}
 
-		var rowsInCol []writerCell
+		var header Header
+		if len(headers) > 0 {
+			header = headers[i]
+		}
 
-		cleanStr := strings.Replace(val.String(), "\r", "", -1)
-		lines := strings.Split(cleanStr, "\n")
+		_, isEmpty := val.(EmptyValue)
 
-		if len(lines) == 1 && lines[0] == "" {
-			cell := writerCell{Value: val, String: w.emptyStr}
+		col := writerCol{
+			Cell: writerCell{
+				Value:   val,
+				String:  w.formatCell(val, header),
+				IsEmpty: isEmpty,
+				Align:   resolveAlignment(header, val),
+			},
+		}
 
-			if reflect.TypeOf(val) == reflect.TypeOf(EmptyValue{}) {
-				cell.IsEmpty = true
-			}
-			rowsInCol = append(rowsInCol, cell)
-		} else {
-			for _, line := range lines {
-				cell := writerCell{Value: val, String: line}
-				if reflect.TypeOf(val) == reflect.TypeOf(EmptyValue{}) {
-					cell.IsEmpty = true
-				}
-				rowsInCol = append(rowsInCol, cell)
-			}
+		if strings.ContainsAny(col.Cell.String, "\r\n") {
+			cleanStr := strings.Replace(col.Cell.String, "\r", "", -1)
+			col.Lines = strings.Split(cleanStr, "\n")
+			col.Cell.String = col.Lines[0]
 		}
 
-		rowsInColLen := len(rowsInCol)
+		if len(col.Lines) <= 1 && len(col.Cell.String) == 0 {
+			col.Lines = nil
+			col.Cell.String = w.emptyStr
 
-		for _, cell := range rowsInCol {
-			if len(cell.String) > w.widths[visibleHeaderIndex] {
-				w.widths[visibleHeaderIndex] = len(cell.String)
+			if _, isBlank := val.(blankValue); isBlank {
+				col.Cell.String = ""
 			}
 		}
 
-		colsWithRows = append(colsWithRows, rowsInCol)
-
-		if rowsInColLen > rowsToAdd {
-			rowsToAdd = rowsInColLen
+		if len(col.Lines) > rowsToAdd {
+			rowsToAdd = len(col.Lines)
 		}
 
-		visibleHeaderIndex++
+		cols = append(cols, col)
 	}
 
 	for i := 0; i < rowsToAdd; i++ {
-		var row writerRow
-
-		rowIsSeparator := true
-		for _, col := range colsWithRows {
-			if i < len(col) {
-				row.Values = append(row.Values, col[i])
-				if !col[i].IsEmpty {
-					rowIsSeparator = false
+		row := writerRow{
+			Values:         make([]writerCell, len(cols)),
+			IsSpacer:       true,
+			IsContinuation: i > 0,
+		}
+
+		for colIdx, col := range cols {
+			cell := col.Cell
+
+			if len(col.Lines) > 0 {
+				if i >= len(col.Lines) {
+					continue
 				}
-			} else {
-				row.Values = append(row.Values, writerCell{})
+				cell.String = col.Lines[i]
+			} else if i > 0 {
+				continue
+			}
+
+			cell.Width = displayWidth(cell.String)
+
+			if !w.started && cell.Width > w.widths[colIdx] {
+				w.widths[colIdx] = cell.Width
+			}
+
+			if !cell.IsEmpty {
+				row.IsSpacer = false
 			}
+
+			row.Values[colIdx] = cell
 		}
-		row.IsSpacer = rowIsSeparator
 
 		w.rows = append(w.rows, row)
 	}
 }
 
+func (w *Writer) formatCell(val Value, header Header) string {
+	switch typedVal := val.(type) {
+	case ValueLink:
+		return typedVal.Format(w.linkStyle)
+	case ValueFmt:
+		if link, ok := typedVal.V.(ValueLink); ok {
+			return link.Format(w.linkStyle)
+		}
+	}
+	return formatValue(val, header.NumberFormat)
+}
+
+// WriteRule adds a separator line spanning all columns
+func (w *Writer) WriteRule() {
+	w.rows = append(w.rows, writerRow{IsRule: true})
+}
+
+// WriteText adds a line that is not aligned to columns (e.g. group title)
+func (w *Writer) WriteText(text string) {
+	w.rows = append(w.rows, writerRow{Text: text, IsText: true})
+}
+
 func (w *Writer) Flush() error {
-	for _, row := range w.rows {
-		if row.IsSpacer {
-			_, err := fmt.Fprintln(w.w)
-			if err != nil {
-				return err
-			}
-			continue
+	err := w.FlushRows()
+	if err != nil {
+		return err
+	}
+
+	if w.chars.Framed {
+		w.writeLine(w.chars.Bottom)
+	}
+
+	return w.w.Flush()
+}
+
+// FlushRows writes rows added so far and releases them.
+// Column widths are fixed after first call, so that
+// subsequent rows could be written incrementally.
+func (w *Writer) FlushRows() error {
+	if !w.started {
+		w.started = true
+
+		if w.chars.Framed {
+			w.writeLine(w.chars.Top)
 		}
+	}
 
-		lastColIdx := len(row.Values) - 1
-		for colIdx, col := range row.Values {
-			if customWriter, ok := col.Value.(hasCustomWriter); ok {
-				_, err := customWriter.Fprintf(w.w, "%s", col.String)
-				if err != nil {
-					return err
-				}
-			} else {
-				_, err := fmt.Fprintf(w.w, "%s", col.String)
-				if err != nil {
-					return err
-				}
-			}
+	for i, row := range w.rows {
+		w.writeRow(row)
 
-			paddingSize := w.widths[colIdx] - len(col.String)
-			if colIdx == lastColIdx {
-				_, err := fmt.Fprintf(w.w, w.borderStr)
-				if err != nil {
-					return err
-				}
-			} else {
-				_, err := fmt.Fprintf(w.w, strings.Repeat(w.bgStr, paddingSize)+w.borderStr)
-				if err != nil {
-					return err
-				}
-			}
+		lastHeaderRow := row.IsHeader && (i+1 == len(w.rows) || !w.rows[i+1].IsHeader)
+
+		if lastHeaderRow && w.chars.HeaderRule {
+			w.writeSeparator()
 		}
 
-		_, err := fmt.Fprintln(w.w)
-		if err != nil {
-			return err
+		w.prevRow = &w.rows[i]
+	}
+
+	if len(w.rows) > 0 {
+		lastRow := w.rows[len(w.rows)-1]
+		w.prevRow = &lastRow
+		w.rows = nil
+	}
+
+	// Buffered writer keeps first write error and returns it when flushed
+	return w.w.Flush()
+}
+
+func (w *Writer) writeRow(row writerRow) {
+	if row.IsText {
+		if w.chars.Framed {
+			w.writeStrings(w.chars.Vertical, " ", row.Text,
+				w.padding(w.innerWidth()-displayWidth(row.Text)), " ", w.chars.Vertical, "\n")
+			return
 		}
+		w.writeStrings(row.Text, "\n")
+		return
 	}
 
-	return nil
+	if row.IsRule || (row.IsSpacer && w.chars.Framed) {
+		w.writeSeparator()
+		return
+	}
+
+	if row.IsSpacer {
+		w.writeStrings("\n")
+		return
+	}
+
+	if w.chars.RowSeparators && !row.IsContinuation && w.prevRow != nil {
+		prevRow := w.prevRow
+		if !prevRow.IsHeader && !prevRow.IsRule && !prevRow.IsSpacer && !prevRow.IsText {
+			w.writeSeparator()
+		}
+	}
+
+	if w.chars.Framed {
+		w.writeFramedCells(row)
+		return
+	}
+
+	lastColIdx := len(row.Values) - 1
+	for colIdx, col := range row.Values {
+		leftPadding, rightPadding := alignPadding(col.Align, w.widths[colIdx]-col.Width)
+
+		w.writeStrings(w.padding(leftPadding))
+		w.writeCell(col)
+
+		if colIdx == lastColIdx {
+			w.writeStrings(w.borderStr)
+		} else {
+			w.writeStrings(w.padding(rightPadding), w.borderStr)
+		}
+	}
+
+	w.writeStrings("\n")
+}
+
+func (w *Writer) writeFramedCells(row writerRow) {
+	w.writeStrings(w.chars.Vertical)
+
+	for colIdx := 0; colIdx < len(w.widths); colIdx++ {
+		var col writerCell
+		if colIdx < len(row.Values) {
+			col = row.Values[colIdx]
+		}
+
+		leftPadding, rightPadding := alignPadding(col.Align, w.widths[colIdx]-col.Width)
+
+		w.writeStrings(" ", w.padding(leftPadding))
+		w.writeCell(col)
+		w.writeStrings(w.padding(rightPadding), " ", w.chars.Vertical)
+	}
+
+	w.writeStrings("\n")
+}
+
+func (w *Writer) writeCell(col writerCell) {
+	if customWriter, ok := col.Value.(hasCustomWriter); ok {
+		customWriter.Fprintf(w.w, "%s", col.String)
+		return
+	}
+	w.writeStrings(col.String)
+}
+
+// writeStrings writes strings as is (without interpreting them as format strings)
+func (w *Writer) writeStrings(strs ...string) {
+	for _, str := range strs {
+		w.w.WriteString(str)
+	}
+}
+
+// padding returns background string repeated given number of times
+func (w *Writer) padding(size int) string {
+	if size <= 0 {
+		return ""
+	}
+	if len(w.paddingStr) < size*len(w.bgStr) {
+		w.paddingStr = strings.Repeat(w.bgStr, size)
+	}
+	return w.paddingStr[:size*len(w.bgStr)]
+}
+
+func (w *Writer) writeSeparator() {
+	if w.chars.Framed {
+		w.writeLine(w.chars.Middle)
+	} else {
+		w.writeRule()
+	}
+}
+
+// writeLine draws horizontal frame line with given junctions
+func (w *Writer) writeLine(junctions [3]string) {
+	w.writeStrings(junctions[0])
+
+	for colIdx := 0; colIdx < len(w.widths); colIdx++ {
+		if colIdx > 0 {
+			w.writeStrings(junctions[1])
+		}
+		w.writeStrings(strings.Repeat(w.chars.Horizontal, w.widths[colIdx]+2))
+	}
+
+	w.writeStrings(junctions[2], "\n")
+}
+
+// innerWidth is a width of framed line without outer borders and spaces
+func (w *Writer) innerWidth() int {
+	width := -3
+	for colIdx := 0; colIdx < len(w.widths); colIdx++ {
+		width += w.widths[colIdx] + 3
+	}
+	return width
+}
+
+func (w *Writer) writeRule() {
+	for colIdx := 0; colIdx < len(w.widths); colIdx++ {
+		w.writeStrings(strings.Repeat("-", w.widths[colIdx]), w.borderStr)
+	}
+
+	w.writeStrings("\n")
+}
+
+// displayWidth counts characters instead of bytes
+// ignoring terminal escape sequences (e.g. colors, hyperlinks)
+func displayWidth(str string) int {
+	if !strings.Contains(str, "\x1b") {
+		return utf8.RuneCountInString(str)
+	}
+
+	var width int
+
+	for i := 0; i < len(str); {
+		if str[i] == '\x1b' && i+1 < len(str) {
+			i += escapeSeqLen(str[i:])
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(str[i:])
+		width++
+		i += size
+	}
+
+	return width
+}
+
+// escapeSeqLen returns length of escape sequence at the start of a string
+func escapeSeqLen(str string) int {
+	switch str[1] {
+	case '[': // CSI (e.g. colors) ends with a byte in range @-~
+		for i := 2; i < len(str); i++ {
+			if str[i] >= '@' && str[i] <= '~' {
+				return i + 1
+			}
+		}
+	case ']': // OSC (e.g. hyperlinks) ends with BEL or ST (ESC \)
+		for i := 2; i < len(str); i++ {
+			if str[i] == '\a' {
+				return i + 1
+			}
+			if str[i] == '\x1b' && i+1 < len(str) && str[i+1] == '\\' {
+				return i + 2
+			}
+		}
+	default:
+		return 2
+	}
+	return len(str)
 }
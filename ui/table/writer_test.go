@@ -21,6 +21,20 @@ func TestWriter(t *testing.T) {
 			assert.Equal(t, buf.String(), "c0r0||c1r0||\n")
 		})
 
+		t.Run("writes borders and values verbatim without formatting them", func(t *testing.T) {
+			buf := bytes.NewBufferString("")
+			writer := NewWriter(buf, "empty", "%", "%d|")
+			visibleHeaders := []Header{{Hidden: false}, {Hidden: false}}
+
+			writer.Write(visibleHeaders, []Value{ValueString{S: "%s"}, ValueString{S: "100%"}})
+			writer.Write(visibleHeaders, []Value{ValueString{S: "%v%v"}, ValueString{S: "1"}})
+			writer.Flush()
+			assert.Equal(t, "\n"+buf.String(), `
+%s%%%d|100%%d|
+%v%v%d|1%d|
+`)
+		})
+
 		t.Run("writes multiple rows", func(t *testing.T) {
 			buf := bytes.NewBufferString("")
 			writer := NewWriter(buf, "empty", ".", "||")
@@ -98,6 +112,36 @@ c0r2-extra-extra||c1r2||
 `)
 		})
 
+		t.Run("writes rules spanning all columns", func(t *testing.T) {
+			buf := bytes.NewBufferString("")
+			writer := NewWriter(buf, "empty", ".", "||")
+			visibleHeaders := []Header{{Hidden: false}, {Hidden: false}}
+
+			writer.Write(visibleHeaders, []Value{ValueString{S: "c0r0"}, ValueString{S: "c1r0-extra"}})
+			writer.WriteRule()
+			writer.Write(visibleHeaders, []Value{ValueString{S: "c0r1"}, ValueString{S: "c1r1"}})
+			writer.Flush()
+			assert.Equal(t, "\n"+buf.String(), `
+c0r0||c1r0-extra||
+----||----------||
+c0r1||c1r1||
+`)
+		})
+
+		t.Run("writes text lines without affecting widths", func(t *testing.T) {
+			buf := bytes.NewBufferString("")
+			writer := NewWriter(buf, "empty", ".", "||")
+			visibleHeaders := []Header{{Hidden: false}, {Hidden: false}}
+
+			writer.WriteText("long title text")
+			writer.Write(visibleHeaders, []Value{ValueString{S: "c0r0"}, ValueString{S: "c1r0"}})
+			writer.Flush()
+			assert.Equal(t, "\n"+buf.String(), `
+long title text
+c0r0||c1r0||
+`)
+		})
+
 		t.Run("writes empty special value if values are empty", func(t *testing.T) {
 			buf := bytes.NewBufferString("")
 			writer := NewWriter(buf, "empty", ".", "||")
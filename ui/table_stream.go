@@ -0,0 +1,59 @@
+package ui
+
+import (
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+)
+
+// PrintTableStream prints rows as they are received (see Table.PrintStream).
+// UIs that cannot stream tables (see TableStreamUI) print table once all rows are received.
+func PrintTableStream(ui UI, table Table, rows RowIterator, opts StreamOpts) {
+	if streamUI, ok := ui.(TableStreamUI); ok {
+		streamUI.PrintTableStream(table, rows, opts)
+		return
+	}
+	ui.PrintTable(collectTableRows(table, rows))
+}
+
+// collectTableRows replaces table rows with received rows
+// (table's own rows are ignored when streaming)
+func collectTableRows(table Table, rows RowIterator) Table {
+	table.Rows = nil
+	table.Sections = nil
+
+	for {
+		row, ok := rows()
+		if !ok {
+			break
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	return table
+}
+
+// mapRows configures each received row the same way
+// as wrapping UI configures rows of printed tables
+func mapRows(rows RowIterator, mapFunc func([]Value) []Value) RowIterator {
+	return func() ([]Value, bool) {
+		row, ok := rows()
+		if !ok {
+			return nil, false
+		}
+		return mapFunc(append([]Value{}, row...)), true
+	}
+}
+
+func (ui *WriterUI) PrintTableStream(table Table, rows RowIterator, opts StreamOpts) {
+	table = ui.configureTable(table)
+
+	// Transposed tables are printed row by row so all rows are necessary
+	if table.Transpose {
+		ui.PrintTable(collectTableRows(table, rows))
+		return
+	}
+
+	err := table.PrintStream(ui.outWriter, rows, opts)
+	if err != nil {
+		ui.logger.Error(ui.logTag, "UI.PrintTableStream failed: %s", err)
+	}
+}
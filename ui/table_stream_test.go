@@ -0,0 +1,173 @@
+package ui_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	. "github.com/cppforlife/go-cli-ui/ui"
+	fakeui "github.com/cppforlife/go-cli-ui/ui/fakes"
+	. "github.com/cppforlife/go-cli-ui/ui/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintTableStream(t *testing.T) {
+	sliceRows := func(rows ...[]Value) RowIterator {
+		return func() ([]Value, bool) {
+			if len(rows) == 0 {
+				return nil, false
+			}
+			row := rows[0]
+			rows = rows[1:]
+			return row, true
+		}
+	}
+
+	t.Run("WriterUI", func(t *testing.T) {
+		t.Run("prints rows as they are received", func(t *testing.T) {
+			uiOut := bytes.NewBufferString("")
+			ui := NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger())
+
+			PrintTableStream(ui, Table{
+				Title:     "Title",
+				Header:    []Header{NewHeader("Name"), NewHeader("State")},
+				BorderStr: " ",
+			}, sliceRows(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+				[]Value{ValueString{S: "b"}, ValueString{S: "stopped"}},
+			), StreamOpts{SampleSize: 1})
+
+			assert.Equal(t, uiOut.String(), "Title\n\nName State \na    running \nb    stopped \n")
+		})
+
+		t.Run("prints transposed tables once all rows are received", func(t *testing.T) {
+			uiOut := bytes.NewBufferString("")
+			ui := NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger())
+
+			table := Table{
+				Title:     "Title",
+				Header:    []Header{NewHeader("Name"), NewHeader("State")},
+				BorderStr: " ",
+				Transpose: true,
+			}
+
+			PrintTableStream(ui, table, sliceRows(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+			), StreamOpts{})
+
+			assert.Equal(t, uiOut.String(), "Title\n\nName  a \nState running \n")
+		})
+	})
+
+	t.Run("ConfUI", func(t *testing.T) {
+		t.Run("reorders streamed rows according to selected columns", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewWrappingConfUI(parentUI, NewRecordingLogger())
+			ui.SelectColumns([]string{"state", "name"})
+
+			PrintTableStream(ui, Table{
+				Title:     "Title",
+				Header:    []Header{NewHeader("Name"), NewHeader("State")},
+				BorderStr: " ",
+			}, sliceRows(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+			), StreamOpts{})
+
+			assert.Equal(t, parentUI.Table.Header[0].Key, "state")
+			assert.Equal(t, parentUI.Table.Rows, [][]Value{
+				{ValueString{S: "running"}, ValueString{S: "a"}},
+			})
+		})
+
+		t.Run("applies custom columns to streamed rows", func(t *testing.T) {
+			uiOut := bytes.NewBufferString("")
+			ui := NewWrappingConfUI(NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger()), NewRecordingLogger())
+			ui.ShowCustomColumns([]CustomColumn{{Title: "S", Key: "state"}})
+
+			PrintTableStream(ui, Table{
+				Title:     "Title",
+				Header:    []Header{NewHeader("Name"), NewHeader("State")},
+				BorderStr: " ",
+			}, sliceRows(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+			), StreamOpts{})
+
+			assert.Equal(t, uiOut.String(), "Title\n\nS \nrunning \n")
+		})
+
+		t.Run("prints error when column is unknown", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewWrappingConfUI(parentUI, NewRecordingLogger())
+			ui.SelectColumns([]string{"unknown"})
+
+			PrintTableStream(ui, Table{
+				Title:     "Title",
+				Header:    []Header{NewHeader("Name"), NewHeader("State")},
+				BorderStr: " ",
+			}, sliceRows(), StreamOpts{})
+
+			assert.Equal(t, parentUI.Errors, []string{"Unknown column 'unknown' (valid columns: name, state)"})
+			assert.Equal(t, len(parentUI.Tables), 0)
+		})
+
+		t.Run("includes streamed rows in JSON output", func(t *testing.T) {
+			parentUI := &fakeui.FakeUI{}
+			ui := NewWrappingConfUI(parentUI, NewRecordingLogger())
+			ui.EnableJSON()
+			ui.SelectColumns([]string{"state"})
+
+			PrintTableStream(ui, Table{
+				Title:     "Title",
+				Header:    []Header{NewHeader("Name"), NewHeader("State")},
+				BorderStr: " ",
+			}, sliceRows(
+				[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+				[]Value{ValueString{S: "b"}, ValueString{S: "stopped"}},
+			), StreamOpts{})
+			ui.Flush()
+
+			var resp JSONUIResp
+			err := json.Unmarshal([]byte(parentUI.Blocks[0]), &resp)
+			assert.NoError(t, err)
+			assert.Equal(t, resp.Tables[0].Rows, []map[string]string{{"state": "running"}, {"state": "stopped"}})
+		})
+	})
+
+	t.Run("forwards to parent UI through wrapping UIs", func(t *testing.T) {
+		uiOut := bytes.NewBufferString("")
+		writerUI := NewWriterUI(uiOut, bytes.NewBufferString(""), NewRecordingLogger())
+		ui := NewNonTTYUI(NewPaddingUI(NewIndentingUI(NewNonInteractiveUI(writerUI))))
+
+		PrintTableStream(ui, Table{
+			Title:     "Title",
+			Header:    []Header{NewHeader("Name"), NewHeader("State")},
+			BorderStr: " ",
+		}, sliceRows(
+			[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+		), StreamOpts{})
+
+		assert.Equal(t, uiOut.String(), "a\trunning\t\n")
+	})
+
+	t.Run("prints table once all rows are received when UI does not support streaming", func(t *testing.T) {
+		parentUI := &fakeui.FakeUI{}
+		ui := plainUI{parentUI}
+
+		table := Table{
+			Title:     "Title",
+			Header:    []Header{NewHeader("Name"), NewHeader("State")},
+			BorderStr: " ",
+			Rows:      [][]Value{{ValueString{S: "ignored"}, ValueString{S: "running"}}},
+		}
+
+		PrintTableStream(ui, table, sliceRows(
+			[]Value{ValueString{S: "a"}, ValueString{S: "running"}},
+			[]Value{ValueString{S: "b"}, ValueString{S: "stopped"}},
+		), StreamOpts{})
+
+		assert.Equal(t, parentUI.Table.Rows, [][]Value{
+			{ValueString{S: "a"}, ValueString{S: "running"}},
+			{ValueString{S: "b"}, ValueString{S: "stopped"}},
+		})
+	})
+}
@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+// TerminalWidth returns number of columns available to UI's output
+// or 0 if it's unknown (e.g. output is not a terminal)
+func TerminalWidth(ui UI) int {
+	if widthUI, ok := ui.(TerminalWidthUI); ok {
+		return widthUI.TerminalWidth()
+	}
+	return 0
+}
+
+func (ui *WriterUI) TerminalWidth() int {
+	if !ui.IsTTY() {
+		return 0
+	}
+
+	file, ok := ui.outWriter.(*os.File)
+	if !ok {
+		return 0
+	}
+
+	width, _, err := term.GetSize(int(file.Fd()))
+	if err != nil {
+		return 0
+	}
+
+	return width
+}
@@ -1,19 +1,17 @@
 package test
 
 import (
-	"encoding/json"
 	"testing"
 
 	"github.com/cppforlife/go-cli-ui/ui"
+	"github.com/cppforlife/go-cli-ui/ui/jsonclient"
 )
 
 func JSONUIFromBytes(t *testing.T, bytes []byte) ui.JSONUIResp {
-	resp := ui.JSONUIResp{}
-
-	err := json.Unmarshal(bytes, &resp)
+	doc, err := jsonclient.Parse(bytes)
 	if err != nil {
 		t.Fatalf("Expected to successfully unmarshal JSON UI: %s", err)
 	}
 
-	return resp
+	return doc.AsJSONUIResp()
 }
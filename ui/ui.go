@@ -17,6 +17,8 @@ type WriterUI struct {
 	errWriter io.Writer
 	logger    ExternalLogger
 	logTag    string
+
+	ttyOverride *bool
 }
 
 func NewConsoleUI(logger ExternalLogger) *WriterUI {
@@ -34,11 +36,20 @@ func NewWriterUI(outWriter, errWriter io.Writer, logger ExternalLogger) *WriterU
 }
 
 func (ui *WriterUI) IsTTY() bool {
+	if ui.ttyOverride != nil {
+		return *ui.ttyOverride
+	}
+
 	file, ok := ui.outWriter.(*os.File)
 
 	return ok && isatty.IsTerminal(file.Fd())
 }
 
+// OverrideTTY overrides terminal detection of output writer
+func (ui *WriterUI) OverrideTTY(isTTY bool) {
+	ui.ttyOverride = &isTTY
+}
+
 // ErrorLinef starts and ends a text error line
 func (ui *WriterUI) ErrorLinef(pattern string, args ...interface{}) {
 	message := fmt.Sprintf(pattern, args...)
@@ -90,12 +101,26 @@ func (ui *WriterUI) PrintErrorBlock(block string) {
 }
 
 func (ui *WriterUI) PrintTable(table Table) {
-	err := table.Print(ui.outWriter)
+	err := ui.configureTable(table).Print(ui.outWriter)
 	if err != nil {
 		ui.logger.Error(ui.logTag, "UI.PrintTable failed: %s", err)
 	}
 }
 
+func (ui *WriterUI) configureTable(table Table) Table {
+	if table.LinkStyle == LinkStyleDefault && supportsHyperlinks(ui.IsTTY()) {
+		table.LinkStyle = LinkStyleHyperlink
+	}
+	return table
+}
+
+func (ui *WriterUI) FormatLink(text, url string) string {
+	if supportsHyperlinks(ui.IsTTY()) {
+		return ValueLink{Text: text, URL: url}.Format(LinkStyleHyperlink)
+	}
+	return ValueLink{Text: text, URL: url}.Format(LinkStylePlain)
+}
+
 func (ui *WriterUI) AskForText(opts TextOpts) (string, error) {
 	if opts.ValidateFunc == nil {
 		opts.ValidateFunc = func(s string) (bool, string, error) {